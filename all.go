@@ -0,0 +1,89 @@
+package mbti
+
+import "unicode"
+
+// All returns all 16 personality types, ordered by their letters: E before I,
+// S before N, T before F and J before P.
+func All() []*Personality {
+	ret := make([]*Personality, 0, 16)
+
+	for _, focus := range []Focus{FocusExternal, FocusInternal} {
+		for _, perceiving := range []Kind{KindSensation, KindIntuition} {
+			for _, judging := range []Kind{KindThinking, KindFeeling} {
+				for _, tactics := range []rune{tacticJudging, tacticProspecting} {
+					p, _ := FromIndicator(string([]rune{rune(focus), rune(perceiving), rune(judging), tactics}))
+					ret = append(ret, p)
+				}
+			}
+		}
+	}
+
+	return ret
+}
+
+// Predicate reports whether a personality meets a condition.
+type Predicate func(p *Personality) bool
+
+// Filter returns the personality types that meet all the predicates, in the order of All.
+//
+//	mbti.Filter(mbti.ByTemperament(mbti.TemperamentNT), mbti.WithLetter('I'))
+func Filter(predicates ...Predicate) []*Personality {
+	var ret []*Personality
+
+outer:
+	for _, p := range All() {
+		for _, matches := range predicates {
+			if !matches(p) {
+				continue outer
+			}
+		}
+
+		ret = append(ret, p)
+	}
+
+	return ret
+}
+
+// ByTemperament matches the personalities of the temperament.
+func ByTemperament(t Temperament) Predicate {
+	return func(p *Personality) bool {
+		return p.Temperament() == t
+	}
+}
+
+// ByDominant matches the personalities whose dominant function is the given one.
+func ByDominant(fn Function) Predicate {
+	return WithFunction(fn, PositionDominant)
+}
+
+// WithFunction matches the personalities that have the function in the given
+// position of their stack, such as PositionAuxiliary.
+func WithFunction(fn Function, position int) Predicate {
+	return func(p *Personality) bool {
+		fns := p.Functions()
+
+		return position >= 0 && position < len(fns) && fns[position] == fn
+	}
+}
+
+// WithLetter matches the personalities whose indicator has the letter, ignoring case.
+func WithLetter(letter rune) Predicate {
+	letter = unicode.ToUpper(letter)
+
+	return func(p *Personality) bool {
+		for _, r := range p.Indicator() {
+			if r == letter {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not matches the personalities the predicate doesn't match.
+func Not(predicate Predicate) Predicate {
+	return func(p *Personality) bool {
+		return !predicate(p)
+	}
+}
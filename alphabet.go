@@ -0,0 +1,192 @@
+package mbti
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Alphabet is a set of letters functions are written with, for literatures
+// that don't use the English ones: German texts write intuition as I, from
+// "Intuition", and sensation as E, from "Empfindung". Letters are matched
+// ignoring case; functions are formatted with the kind letter in uppercase
+// and the focus letter in lowercase, as in "Ii" for Ni.
+//
+// Every function formatted with a valid alphabet is parsed back by it.
+type Alphabet struct {
+	// Name is the name the alphabet is registered under, such as a language tag.
+	Name string
+	// Kinds maps the English kind letters, such as KindIntuition, to the letters of the alphabet.
+	Kinds map[Kind]rune
+	// Introverted and Extraverted are the letters of the focus of the functions.
+	Introverted rune
+	Extraverted rune
+}
+
+var ErrInvalidAlphabet = errors.New("invalid alphabet")
+
+var (
+	AlphabetEnglish = Alphabet{
+		Name:        "en",
+		Kinds:       map[Kind]rune{KindIntuition: 'N', KindSensation: 'S', KindThinking: 'T', KindFeeling: 'F'},
+		Introverted: 'i',
+		Extraverted: 'e',
+	}
+	// AlphabetGerman uses the letters of Jung's terms: Intuition, Empfindung, Denken and Fühlen.
+	AlphabetGerman = Alphabet{
+		Name:        "de",
+		Kinds:       map[Kind]rune{KindIntuition: 'I', KindSensation: 'E', KindThinking: 'D', KindFeeling: 'F'},
+		Introverted: 'i',
+		Extraverted: 'e',
+	}
+)
+
+// Validate checks that the alphabet has a letter for each kind and focus,
+// and that no two kinds or foci share a letter, so that functions round-trip.
+func (a Alphabet) Validate() error {
+	seen := map[rune]Kind{}
+
+	for _, kind := range []Kind{KindIntuition, KindSensation, KindThinking, KindFeeling} {
+		letter, ok := a.Kinds[kind]
+		if !ok || letter == 0 {
+			return fmt.Errorf("%w %q: no letter for kind %c", ErrInvalidAlphabet, a.Name, kind)
+		}
+
+		folded := unicode.ToUpper(letter)
+		if other, ok := seen[folded]; ok {
+			return fmt.Errorf("%w %q: kinds %c and %c are both written as %c", ErrInvalidAlphabet, a.Name, other, kind, letter)
+		}
+
+		seen[folded] = kind
+	}
+
+	if a.Introverted == 0 || a.Extraverted == 0 {
+		return fmt.Errorf("%w %q: no letter for the focus", ErrInvalidAlphabet, a.Name)
+	}
+
+	if unicode.ToLower(a.Introverted) == unicode.ToLower(a.Extraverted) {
+		return fmt.Errorf("%w %q: both foci are written as %c", ErrInvalidAlphabet, a.Name, a.Introverted)
+	}
+
+	return nil
+}
+
+// Format writes the function in the alphabet.
+func (a Alphabet) Format(f Function) string {
+	focus := a.Extraverted
+	if f.IsIntroverted() {
+		focus = a.Introverted
+	}
+
+	return string(unicode.ToUpper(a.Kinds[f.kind])) + string(unicode.ToLower(focus))
+}
+
+// FormatFunctions writes the functions in the alphabet, one after another.
+func (a Alphabet) FormatFunctions(functions []Function) string {
+	var b strings.Builder
+	for _, fn := range functions {
+		b.WriteString(a.Format(fn))
+	}
+
+	return b.String()
+}
+
+// ParseFunctions parses functions written one after another in the alphabet,
+// such as "IiDe". It is the counterpart of FunctionsFromString.
+func (a Alphabet) ParseFunctions(s string) ([]Function, error) {
+	letters := []rune(s)
+	if len(letters)%2 == 1 {
+		return nil, fmt.Errorf("%w: functions string must have even length", ErrInvalidFunctionsString)
+	}
+
+	funcs := make([]Function, 0, len(letters)/2)
+
+	for i := 0; i < len(letters); i += 2 {
+		fn, ok := a.function(letters[i], letters[i+1])
+		if !ok {
+			return nil, fmt.Errorf("%w %q in alphabet %q", ErrInvalidFunctionsString, string(letters[i:i+2]), a.Name)
+		}
+
+		funcs = append(funcs, fn)
+	}
+
+	return funcs, nil
+}
+
+func (a Alphabet) function(kindLetter, focusLetter rune) (Function, bool) {
+	var fn Function
+
+	for kind, letter := range a.Kinds {
+		if unicode.ToUpper(letter) == unicode.ToUpper(kindLetter) {
+			fn.kind = kind
+		}
+	}
+
+	switch unicode.ToLower(focusLetter) {
+	case unicode.ToLower(a.Introverted):
+		fn.focus = FocusInternal
+	case unicode.ToLower(a.Extraverted):
+		fn.focus = FocusExternal
+	}
+
+	return fn, isValidFunction(fn)
+}
+
+// clone returns the alphabet with its own copy of the kind letters, so that
+// changes to the map of one don't affect the other.
+func (a Alphabet) clone() Alphabet {
+	kinds := make(map[Kind]rune, len(a.Kinds))
+	for kind, letter := range a.Kinds {
+		kinds[kind] = letter
+	}
+
+	a.Kinds = kinds
+
+	return a
+}
+
+var (
+	alphabets = map[string]Alphabet{
+		AlphabetEnglish.Name: AlphabetEnglish.clone(),
+		AlphabetGerman.Name:  AlphabetGerman.clone(),
+	}
+	alphabetsMu sync.RWMutex
+)
+
+// RegisterAlphabet adds an alphabet, replacing any alphabet with the same name.
+// Invalid alphabets are rejected. The alphabet is copied, so changing its
+// letters afterwards doesn't affect the registered one.
+func RegisterAlphabet(a Alphabet) error {
+	if err := a.Validate(); err != nil {
+		return err
+	}
+
+	alphabetsMu.Lock()
+	defer alphabetsMu.Unlock()
+
+	alphabets[strings.ToLower(a.Name)] = a.clone()
+
+	return nil
+}
+
+// LookupAlphabet returns a copy of the alphabet registered under a name. Language
+// tags and POSIX locale names are looked up by their language, as by LookupLocale.
+func LookupAlphabet(name string) (Alphabet, bool) {
+	alphabetsMu.RLock()
+	defer alphabetsMu.RUnlock()
+
+	a, ok := alphabets[strings.ToLower(name)]
+	if !ok {
+		if i := strings.IndexAny(name, "-_.@"); i >= 0 {
+			a, ok = alphabets[strings.ToLower(name[:i])]
+		}
+	}
+
+	if !ok {
+		return Alphabet{}, false
+	}
+
+	return a.clone(), true
+}
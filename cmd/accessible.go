@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// accessible enables the screen reader friendly output. It is set with the
+// -accessible flag, the MBTI_ACCESSIBLE environment variable or the
+// "accessible" setting of the configuration file. The output then has no
+// animations, cursor movement or styling, and every piece of information
+// is labeled explicitly instead of relying on layout.
+var accessible = settings.Accessible || envBool("MBTI_ACCESSIBLE")
+
+// describeFunctions spells out the functions with their position in the stack,
+// for example "dominant Introverted Intuition (Ni)".
+func describeFunctions(functions []mbti.Function) string {
+	parts := make([]string, 0, len(functions))
+	for i, fn := range functions {
+		parts = append(parts, fmt.Sprintf("%s %s (%s)", strings.ToLower(mbti.PositionNames[i]), fn.Description().Name, fn))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// describeStack is the accessible replacement of the stack diagram.
+func describeStack(p *mbti.Personality) string {
+	return fmt.Sprintf("Function stack of %s: %s.\n", p, describeFunctions(p.Functions()))
+}
+
+// describeComparison is the accessible replacement of the comparison diagram.
+func describeComparison(c *mbti.Comparison) string {
+	return describeStack(c.A) + describeStack(c.B) +
+		fmt.Sprintf("Functions in the same position: %s.\n", formatFunctionsOrNone(c.SamePosition))
+}
+
+// describeFlow is the accessible replacement of the derivation flowchart.
+func describeFlow(p *mbti.Personality) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Derivation of %s:\n", p)
+	for i, step := range mbti.DerivationSteps(p) {
+		fmt.Fprintf(&b, "Step %d: %s\n", i+1, step)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var errUnauthorized = errors.New("missing or invalid API key")
+
+// apiKeys returns the API keys of the server: the ones in the configuration file
+// and the ones in the MBTI_API_KEYS environment variable, a comma separated list
+// of keys optionally prefixed by a name, as in "ci:secret1,bot:secret2".
+// If there are any, the web UI is disabled, as browsers can't send the key
+// with the event stream it uses.
+func apiKeys() []apiKeyConfig {
+	keys := append([]apiKeyConfig(nil), settings.APIKeys...)
+
+	for i, entry := range strings.Split(os.Getenv("MBTI_API_KEYS"), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		name, key, ok := strings.Cut(entry, ":")
+		if !ok {
+			name, key = fmt.Sprintf("env-%d", i+1), entry
+		}
+
+		keys = append(keys, apiKeyConfig{Name: name, Key: key})
+	}
+
+	for i := range keys {
+		if keys[i].Name == "" {
+			keys[i].Name = fmt.Sprintf("key-%d", i+1)
+		}
+	}
+
+	return keys
+}
+
+// requestInfo is filled by the middlewares with details about a request, which are logged by logRequests.
+type requestInfo struct {
+	apiKey *apiKeyConfig
+}
+
+type requestInfoKey struct{}
+
+func withRequestInfo(ctx context.Context, info *requestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// publicPaths are the health checks probed by infrastructure, which don't
+// require an API key. Unlike for rate limiting, the metrics aren't public.
+var publicPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+}
+
+// authenticate rejects the requests without a valid API key, except for the health checks.
+func authenticate(keys []apiKeyConfig, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if publicPaths[r.URL.Path] {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		key := findAPIKey(keys, apiKey(r))
+		if key == nil {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="mbti"`)
+			writeError(w, http.StatusUnauthorized, errUnauthorized)
+
+			return
+		}
+
+		info, ok := r.Context().Value(requestInfoKey{}).(*requestInfo)
+		if !ok {
+			info = &requestInfo{}
+			r = r.WithContext(withRequestInfo(r.Context(), info))
+		}
+
+		info.apiKey = key
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// findAPIKey returns the configured key matching the given one, comparing in constant time.
+func findAPIKey(keys []apiKeyConfig, key string) *apiKeyConfig {
+	if key == "" {
+		return nil
+	}
+
+	var found *apiKeyConfig
+
+	for i := range keys {
+		if subtle.ConstantTimeCompare([]byte(keys[i].Key), []byte(key)) == 1 {
+			found = &keys[i]
+		}
+	}
+
+	return found
+}
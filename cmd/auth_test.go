@@ -0,0 +1,61 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAuthenticate(t *testing.T) {
+	keys := []apiKeyConfig{{Name: "ci", Key: "secret"}}
+
+	var gotKey *apiKeyConfig
+
+	handler := authenticate(keys, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if info, ok := r.Context().Value(requestInfoKey{}).(*requestInfo); ok {
+			gotKey = info.apiKey
+		}
+	}))
+
+	tests := []struct {
+		name   string
+		path   string
+		header string
+		value  string
+		want   int
+	}{
+		{"health check", "/healthz", "", "", http.StatusOK},
+		{"readiness check", "/readyz", "", "", http.StatusOK},
+		{"metrics", "/metrics", "", "", http.StatusUnauthorized},
+		{"missing key", "/types", "", "", http.StatusUnauthorized},
+		{"invalid key", "/types", "X-API-Key", "wrong", http.StatusUnauthorized},
+		{"header key", "/types", "X-API-Key", "secret", http.StatusOK},
+		{"bearer token", "/types", "Authorization", "Bearer secret", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKey = nil
+
+			r := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.header != "" {
+				r.Header.Set(tt.header, tt.value)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tt.want {
+				t.Fatalf("got status %d, want %d", w.Code, tt.want)
+			}
+
+			if w.Code == http.StatusUnauthorized && w.Header().Get("WWW-Authenticate") == "" {
+				t.Error("missing WWW-Authenticate header")
+			}
+
+			if tt.value != "" && w.Code == http.StatusOK && (gotKey == nil || gotKey.Name != "ci") {
+				t.Errorf("got key %v, want ci", gotKey)
+			}
+		})
+	}
+}
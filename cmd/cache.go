@@ -0,0 +1,191 @@
+package main
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cachedResponse is a rendered JSON response body and its entity tag.
+type cachedResponse struct {
+	body []byte
+	etag string
+}
+
+func newCachedResponse(body []byte) *cachedResponse {
+	sum := sha256.Sum256(body)
+
+	return &cachedResponse{body: body, etag: `"` + hex.EncodeToString(sum[:16]) + `"`}
+}
+
+// responseCache caches rendered responses in memory and, if dir is
+// not empty, on disk. As the personality data is static, entries never
+// expire, but at most maxEntries are kept in memory, evicting the least
+// recently used ones.
+type responseCache struct {
+	dir        string
+	maxAge     time.Duration
+	maxEntries int
+
+	entries map[string]*list.Element
+	// order lists the entries from the most to the least recently used.
+	order *list.List
+	mu    sync.Mutex
+}
+
+type cacheEntry struct {
+	key      string
+	response *cachedResponse
+}
+
+func newResponseCache(dir string, maxAge time.Duration, maxEntries int) (*responseCache, error) {
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+
+	return &responseCache{
+		dir:        dir,
+		maxAge:     maxAge,
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}, nil
+}
+
+// cacheKey builds the key of a response from the endpoint, the canonical
+// forms of the requested types and the rendering options. The model and
+// the metadata overlays are part of the key, so the responses cached on
+// disk aren't served after a restart with different ones.
+func cacheKey(endpoint string, types []string, options string) string {
+	return strings.Join([]string{endpoint, strings.Join(types, ","), options, modelName, dataHash}, "|")
+}
+
+func (c *responseCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *responseCache) load(key string) *cachedResponse {
+	c.mu.Lock()
+	elem, ok := c.entries[key]
+	if ok {
+		c.order.MoveToFront(elem)
+	}
+	c.mu.Unlock()
+
+	if ok {
+		return elem.Value.(*cacheEntry).response
+	}
+
+	if c.dir == "" {
+		return nil
+	}
+
+	body, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil
+	}
+
+	entry := newCachedResponse(body)
+	c.store(key, entry, false)
+
+	return entry
+}
+
+func (c *responseCache) store(key string, entry *cachedResponse, persist bool) {
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		elem.Value.(*cacheEntry).response = entry
+		c.order.MoveToFront(elem)
+	} else {
+		c.entries[key] = c.order.PushFront(&cacheEntry{key: key, response: entry})
+	}
+
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		oldest := c.order.Remove(c.order.Back()).(*cacheEntry)
+		delete(c.entries, oldest.key)
+	}
+	c.mu.Unlock()
+
+	if !persist || c.dir == "" {
+		return
+	}
+
+	// Write to a temporary file first, so concurrent readers never see partial files.
+	tmp, err := os.CreateTemp(c.dir, "tmp-")
+	if err == nil {
+		_, err = tmp.Write(entry.body)
+		if closeErr := tmp.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err == nil {
+			err = os.Rename(tmp.Name(), c.path(key))
+		} else {
+			_ = os.Remove(tmp.Name())
+		}
+	}
+
+	if err != nil {
+		slog.Warn("failed to write cache entry", "err", err)
+	}
+}
+
+// get returns the cached response for the key, rendering it using
+// render on cache misses.
+func (c *responseCache) get(key string, render func() (interface{}, error)) (*cachedResponse, error) {
+	if entry := c.load(key); entry != nil {
+		return entry, nil
+	}
+
+	v, err := render()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := newCachedResponse(append(body, '\n'))
+	c.store(key, entry, true)
+
+	return entry, nil
+}
+
+// write writes the cached response for the key, answering conditional
+// requests with 304 Not Modified.
+func (c *responseCache) write(w http.ResponseWriter, r *http.Request, key string, render func() (interface{}, error)) {
+	entry, err := c.get(key, render)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	h := w.Header()
+	h.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(c.maxAge.Seconds())))
+	h.Set("ETag", entry.etag)
+
+	if match := r.Header.Get("If-None-Match"); match != "" && strings.Contains(match, entry.etag) {
+		w.WriteHeader(http.StatusNotModified)
+
+		return
+	}
+
+	h.Set("Content-Type", "application/json")
+	_, _ = w.Write(entry.body)
+}
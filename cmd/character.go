@@ -0,0 +1,191 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// speechTendencies describe how characters talk, keyed by their dominant function.
+var speechTendencies = map[mbti.Function]string{
+	mbti.Ni: "Speaks rarely but conclusively, jumping to where things are heading and leaving out the steps in between.",
+	mbti.Ne: "Talks in tangents and what-ifs, riffing on ideas and finishing other people's sentences with new possibilities.",
+	mbti.Si: "Anchors the conversation in past experience and precise details, with phrases like \"last time we did this...\".",
+	mbti.Se: "Direct and vivid, reacts to what is happening right now and prefers action to long discussion.",
+	mbti.Ti: "Chooses words carefully, qualifies statements and pokes holes in vague arguments.",
+	mbti.Te: "Blunt and to the point, talks in plans, deadlines and measurable results.",
+	mbti.Fi: "Reserved until something touches their values, then speaks with quiet, unshakable conviction.",
+	mbti.Fe: "Warm and attentive, mirrors the mood of the room and checks in on how everyone feels.",
+}
+
+// characterNames are used for characters whose name isn't given.
+var characterNames = []string{"Ada", "Basil", "Clara", "Dorian", "Elena", "Felix", "Greta", "Hugo", "Iris", "Jonah", "Katya", "Leon", "Mira", "Nico", "Olive", "Pavel"}
+
+// characterRoles are the relations suggested as story roles when no other characters are given.
+var characterRoles = []struct {
+	relation mbti.RelationType
+	role     string
+}{
+	{mbti.RelationDuality, "Confidant who completes them"},
+	{mbti.RelationConflict, "Rival who rubs them the wrong way"},
+	{mbti.RelationSupervisee, "Critic who exposes their weak spot"},
+	{mbti.RelationBeneficiary, "Mentor they look up to"},
+}
+
+type characterRelationJSON struct {
+	Name        string `json:"name"`
+	Personality string `json:"personality"`
+	Relation    string `json:"relation"`
+	Dynamics    string `json:"dynamics"`
+}
+
+type characterJSON struct {
+	Name        string                  `json:"name"`
+	Personality string                  `json:"personality"`
+	Nickname    string                  `json:"nickname,omitempty"`
+	Temperament string                  `json:"temperament"`
+	Description string                  `json:"description,omitempty"`
+	Stack       []string                `json:"stack"`
+	Stress      string                  `json:"stress"`
+	Speech      string                  `json:"speech"`
+	Relations   []characterRelationJSON `json:"relations"`
+}
+
+func runCharacter(args []string) error {
+	flags := newFlagSet("character")
+	name := flags.String("name", "", "The name of the character. A random one is picked if empty")
+	seed := flags.Int64("seed", 0, "The seed of the random generator picking the type and name. The current time is used if 0")
+
+	var others []mbti.TeamMember
+	flags.Func("with", "Another character, as NAME=TYPE, to describe the relation with. Can be repeated", func(s string) error {
+		n, input, ok := strings.Cut(s, "=")
+		if !ok {
+			return fmt.Errorf("expected NAME=TYPE, got %q", s)
+		}
+
+		p, err := parseInput(input)
+		if err != nil {
+			return err
+		}
+
+		others = append(others, mbti.TeamMember{Name: n, Personality: p})
+
+		return nil
+	})
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 1 {
+		return fmt.Errorf("%w: expected at most one personality type", errArguments)
+	}
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	rng := rand.New(rand.NewSource(*seed))
+
+	var p *mbti.Personality
+	if flags.NArg() == 1 {
+		var err error
+		if p, err = parseInput(flags.Arg(0)); err != nil {
+			return err
+		}
+	} else {
+		all := mbti.All()
+		p = all[rng.Intn(len(all))]
+	}
+
+	if *name == "" {
+		*name = characterNames[rng.Intn(len(characterNames))]
+	}
+
+	c := newCharacter(*name, p, others)
+
+	if outputFormat == formatJSON {
+		return printJSON(c)
+	}
+
+	printCharacter(c)
+
+	return nil
+}
+
+func newCharacter(name string, p *mbti.Personality, others []mbti.TeamMember) characterJSON {
+	fns := p.Functions()
+	inferior := fns[mbti.PositionInferior]
+
+	c := characterJSON{
+		Name:        name,
+		Personality: p.String(),
+		Nickname:    p.Nickname(),
+		Temperament: fmt.Sprintf("%s (%s)", p.Temperament(), p.Temperament().Name()),
+		Description: p.Description(),
+		Stress:      fmt.Sprintf("The inferior %s takes over. %s", inferior, inferior.Description().Positions[mbti.PositionInferior]),
+		Speech:      speechTendencies[fns[mbti.PositionDominant]],
+		Relations:   []characterRelationJSON{},
+	}
+
+	for i, fn := range fns {
+		text := fn.Description().Positions[i]
+		// The inferior function's position describes stress, which has its own section.
+		if i == mbti.PositionInferior {
+			text = fn.Description().Name + "."
+		}
+
+		c.Stack = append(c.Stack, fmt.Sprintf("%s %s: %s", mbti.PositionNames[i], fn, text))
+	}
+
+	if len(others) > 0 {
+		for _, o := range others {
+			r := mbti.Relationship(p, o.Personality)
+			c.Relations = append(c.Relations, characterRelationJSON{
+				Name:        o.Name,
+				Personality: o.Personality.String(),
+				Relation:    r.String(),
+				Dynamics:    r.Description().Dynamics,
+			})
+		}
+
+		return c
+	}
+
+	for _, role := range characterRoles {
+		c.Relations = append(c.Relations, characterRelationJSON{
+			Name:        role.role,
+			Personality: p.Partner(role.relation).String(),
+			Relation:    role.relation.String(),
+			Dynamics:    role.relation.Description().Dynamics,
+		})
+	}
+
+	return c
+}
+
+func printCharacter(c characterJSON) {
+	fmt.Printf("%s, %s", c.Name, c.Personality)
+	if c.Nickname != "" {
+		fmt.Printf(" (%s)", c.Nickname)
+	}
+	fmt.Printf(", %s\n", c.Temperament)
+
+	if c.Description != "" {
+		fmt.Printf("%s\n", c.Description)
+	}
+
+	fmt.Printf("\nFunction stack:\n")
+	printList(c.Stack)
+
+	fmt.Printf("\nUnder stress: %s\n", c.Stress)
+	fmt.Printf("Speech: %s\n", c.Speech)
+
+	fmt.Printf("\nRelations:\n")
+	for _, r := range c.Relations {
+		fmt.Printf("  %s (%s): %s. %s\n", r.Name, r.Personality, r.Relation, r.Dynamics)
+	}
+}
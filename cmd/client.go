@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti/internal/api"
+)
+
+// defaultSocket is the Unix socket the client connects to if neither
+// the -unix flag nor the MBTI_SOCKET environment variable is set.
+const defaultSocket = "/tmp/mbti.sock"
+
+var (
+	errNotSocket   = errors.New("file exists and is not a socket")
+	errSocketInUse = errors.New("another server is listening on the socket")
+)
+
+// listen listens on the Unix socket at path if it is not empty, and on the TCP address otherwise.
+// A socket left behind by a previous server is removed first, unless a server still answers on it.
+func listen(addr, path string) (net.Listener, error) {
+	if path == "" {
+		return net.Listen("tcp", addr)
+	}
+
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&fs.ModeSocket == 0 {
+			return nil, fmt.Errorf("%w: %s", errNotSocket, path)
+		}
+
+		if conn, err := net.DialTimeout("unix", path, time.Second); err == nil {
+			_ = conn.Close()
+
+			return nil, fmt.Errorf("%w: %s", errSocketInUse, path)
+		}
+
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return net.Listen("unix", path)
+}
+
+func runClient(args []string) error {
+	flags := newFlagSet("client")
+	socket := flags.String("unix", envOr("MBTI_SOCKET", defaultSocket), "The Unix socket of the server started with \"serve -unix\". Defaults to the MBTI_SOCKET environment variable")
+	key := flags.String("api-key", os.Getenv("MBTI_API_KEY"), "The API key sent to the server, if it requires one. Defaults to the MBTI_API_KEY environment variable")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: expected the path to request, such as /types/INTJ", errArguments)
+	}
+
+	path := flags.Arg(0)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	c := &http.Client{Transport: &http.Transport{
+		DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+
+			return d.DialContext(ctx, "unix", *socket)
+		},
+	}}
+
+	// The host is ignored, as the transport always dials the socket.
+	req, err := http.NewRequest(http.MethodGet, "http://mbti"+path, nil)
+	if err != nil {
+		return err
+	}
+
+	if *key != "" {
+		req.Header.Set("X-API-Key", *key)
+	}
+
+	res, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		var e api.Error
+		if err := json.NewDecoder(res.Body).Decode(&e); err != nil || e.Error == "" {
+			return fmt.Errorf("server error: %s", res.Status)
+		}
+
+		return fmt.Errorf("server error: %s", e.Error)
+	}
+
+	_, err = io.Copy(os.Stdout, res.Body)
+
+	return err
+}
+
+func envOr(name, fallback string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+
+	return fallback
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+var errNoClipboard = errors.New("no clipboard utility found")
+
+// copyOutput is set with the -copy flag.
+var copyOutput bool
+
+// outputCopy tees the standard output into a buffer while the output is copied.
+var outputCopy struct {
+	stdout *os.File
+	w      *os.File
+	buf    bytes.Buffer
+	done   chan error
+}
+
+// startCopy replaces the standard output with a pipe, whose contents are
+// written both to the original standard output and to a buffer.
+func startCopy() error {
+	if outputCopy.stdout != nil {
+		return nil
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+
+	outputCopy.stdout, outputCopy.w = os.Stdout, w
+	outputCopy.done = make(chan error, 1)
+
+	dst := io.MultiWriter(os.Stdout, &outputCopy.buf)
+
+	go func() {
+		_, err := io.Copy(dst, r)
+		outputCopy.done <- err
+	}()
+
+	os.Stdout = w
+
+	return nil
+}
+
+// finishCopy restores the standard output and places everything written to it
+// on the clipboard. It does nothing if the output isn't copied.
+func finishCopy() error {
+	if outputCopy.stdout == nil {
+		return nil
+	}
+
+	os.Stdout = outputCopy.stdout
+	outputCopy.stdout = nil
+
+	if err := outputCopy.w.Close(); err != nil {
+		return err
+	}
+
+	if err := <-outputCopy.done; err != nil {
+		return err
+	}
+
+	return writeClipboard(outputCopy.buf.Bytes())
+}
+
+// clipboardCommands are the commands that write their standard input to the clipboard, by platform.
+var clipboardCommands = map[string][][]string{
+	"darwin":  {{"pbcopy"}},
+	"windows": {{"clip"}},
+	"linux":   {{"wl-copy"}, {"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}},
+}
+
+func writeClipboard(data []byte) error {
+	candidates, ok := clipboardCommands[runtime.GOOS]
+	if !ok {
+		// Other Unix systems likely run X11.
+		candidates = clipboardCommands["linux"]
+	}
+
+	for _, args := range candidates {
+		path, err := exec.LookPath(args[0])
+		if err != nil {
+			continue
+		}
+
+		cmd := exec.Command(path, args[1:]...)
+		cmd.Stdin = bytes.NewReader(data)
+
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("copy to clipboard: %w: %s", err, bytes.TrimSpace(out))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("copy to clipboard: %w", errNoClipboard)
+}
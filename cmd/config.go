@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// config holds the settings read from the configuration file, a JSON object
+// such as {"accessible": true}. Flags take precedence over it.
+type config struct {
+	Accessible bool `json:"accessible"`
+	// APIKeys are the keys accepted by the server. Authentication is disabled if there are none.
+	APIKeys []apiKeyConfig `json:"apiKeys,omitempty"`
+	// Webhooks are notified by the server when quizzes are completed.
+	Webhooks []webhookConfig `json:"webhooks,omitempty"`
+}
+
+// apiKeyConfig is an API key of the server. Keys with a rate limit are limited
+// by it instead of the one set with the -rate-limit flag.
+type apiKeyConfig struct {
+	// Name identifies the key in logs, so that the key itself is never logged.
+	Name      string  `json:"name"`
+	Key       string  `json:"key"`
+	RateLimit float64 `json:"rateLimit,omitempty"`
+	RateBurst int     `json:"rateBurst,omitempty"`
+}
+
+// configPath returns the path of the configuration file, which can be
+// changed with the MBTI_CONFIG environment variable.
+func configPath() (string, error) {
+	if path := os.Getenv("MBTI_CONFIG"); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "mbti", "config.json"), nil
+}
+
+// loadConfig reads the configuration file. A missing file is not an error.
+func loadConfig() (config, error) {
+	var c config
+
+	path, err := configPath()
+	if err != nil {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	} else if err != nil {
+		return c, err
+	}
+
+	if err := json.Unmarshal(data, &c); err != nil {
+		return c, fmt.Errorf("invalid configuration file %s: %w", path, err)
+	}
+
+	return c, nil
+}
+
+// settings is the loaded configuration. Loading errors are logged once the logger is configured.
+var settings, settingsErr = loadConfig()
+
+// envBool reads a boolean environment variable, which is false if unset or invalid.
+func envBool(name string) bool {
+	b, _ := strconv.ParseBool(os.Getenv(name))
+
+	return b
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+func runConvert(args []string) error {
+	flags := newFlagSet("convert")
+	notations := strings.Join(mbti.NotationNames(), ", ")
+	from := flags.String("from", "mbti", "The notation of the given types: "+notations)
+	to := flags.String("to", "socionics", "The notation to convert the types to: "+notations)
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		return fmt.Errorf("%w: expected types to convert", errArguments)
+	}
+
+	for _, name := range []string{*from, *to} {
+		if _, err := mbti.LookupNotation(name); err != nil {
+			return &usageError{err: err, flags: flags}
+		}
+	}
+
+	converted := make([]string, 0, flags.NArg())
+
+	for _, input := range flags.Args() {
+		c, err := mbti.Convert(input, *from, *to)
+		if err != nil {
+			return err
+		}
+
+		converted = append(converted, c)
+	}
+
+	if outputFormat == formatJSON {
+		return printJSON(converted)
+	}
+
+	for _, c := range converted {
+		fmt.Println(c)
+	}
+
+	return nil
+}
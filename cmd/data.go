@@ -0,0 +1,80 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// dataDir is the directory metadata overlays are loaded from, set with the -data flag.
+var dataDir string
+
+var dataLoaded bool
+
+// dataHash identifies the contents of the loaded metadata overlays, and is
+// empty if none are loaded.
+var dataHash string
+
+// loadMetadataOverlays registers the metadata found in the JSON files of the
+// directory, in file name order, over the built-in dataset. Each file maps
+// type indicators to their metadata:
+//
+//	{
+//	  "INTJ": {
+//	    "aliases": ["Mastermind"],
+//	    "description": "...",
+//	    "relationNotes": {"ENFP": "..."}
+//	  }
+//	}
+func loadMetadataOverlays(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(paths)
+
+	hash := sha256.New()
+
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		hash.Write(data)
+
+		var overlay mbti.MetadataMap
+		if err := json.Unmarshal(data, &overlay); err != nil {
+			return fmt.Errorf("invalid metadata file %s: %w", path, err)
+		}
+
+		normalized := make(mbti.MetadataMap, len(overlay))
+
+		for indicator, md := range overlay {
+			p, err := mbti.FromIndicator(strings.ToUpper(indicator))
+			if err != nil {
+				return fmt.Errorf("invalid metadata file %s: %w", path, err)
+			}
+
+			normalized[p.Indicator()] = md
+		}
+
+		mbti.RegisterMetadataProvider(normalized)
+		slog.Info("loaded metadata overlay", "path", path, "types", len(normalized))
+	}
+
+	if len(paths) > 0 {
+		dataHash = hex.EncodeToString(hash.Sum(nil)[:8])
+	}
+
+	return nil
+}
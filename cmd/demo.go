@@ -0,0 +1,99 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/delayed"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// demoTypingDuration is how long the demo takes to type an answer.
+const demoTypingDuration = 300 * time.Millisecond
+
+func runDemo(args []string) error {
+	flags := newFlagSet("demo")
+	seed := flags.Int64("seed", 1, "The seed picking the types and answers. The same seed always plays the same demo")
+	instantOutput := flags.Bool("instantOutput", false, "True if you want output to be shown instantly, without a typewriter-like effect")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("%w: demo takes no arguments", errArguments)
+	}
+
+	rng := rand.New(rand.NewSource(*seed))
+	all := mbti.All()
+	a, b := all[rng.Intn(len(all))], all[rng.Intn(len(all))]
+
+	d := newTypewriter(*instantOutput, time.Second, time.Second/2)
+
+	d.Write("mbti demo (seed %d)\n\n", *seed, time.Second).Wait()
+
+	d.Write("1. The mind of a random type: %s, the %s\n\n", a, a.Nickname()).Wait()
+	queueMind(d, a)
+
+	d.Write("2. How %s gets along with %s\n\n", a, b).Wait()
+	queueDemoRelation(d, a, b)
+
+	d.Write("3. A few quiz questions, answered at random\n", time.Second).Wait()
+	result, err := queueDemoQuiz(d, rng)
+	if err != nil {
+		return err
+	}
+
+	queueQuizResult(d, result)
+
+	return <-d.Write("That's it! Run \"mbti quiz\" to find your own type.\n", time.Second).Do()
+}
+
+func queueDemoRelation(d *delayed.Delayed, a, b *mbti.Personality) {
+	c := mbti.Compatibility(a, b)
+	shared := mbti.Compare(a, b)
+
+	d.Write("%s (%s)\n%s (%s)\n", a, formatFunctions(a.Functions()), b, formatFunctions(b.Functions())).
+		Write("Shared functions: %s\n", formatFunctionsOrNone(shared.SharedFunctions)).
+		Write("Relation: %s\n", c.Relation).
+		Write("Compatibility: %.0f%%\n", c.Score*100).Wait()
+
+	for _, reason := range c.Reasons {
+		d.Write("  - %s\n", reason, time.Second)
+	}
+
+	d.Write("\n").Wait()
+}
+
+// queueDemoQuiz queues the first question of each dichotomy with a random answer.
+func queueDemoQuiz(d *delayed.Delayed, rng *rand.Rand) (*quiz.Result, error) {
+	var (
+		questions []quiz.Question
+		answers   []quiz.Answer
+		asked     = map[quiz.Dichotomy]bool{}
+	)
+
+	for _, q := range quiz.DefaultQuestions {
+		if asked[q.Dichotomy] {
+			continue
+		}
+
+		asked[q.Dichotomy] = true
+		questions = append(questions, q)
+	}
+
+	for i, q := range questions {
+		choice := rng.Intn(len(q.Choices))
+		answers = append(answers, quiz.Answer{QuestionID: q.ID, Choice: choice})
+
+		d.Write("\n(%d/%d) %s...\n", i+1, len(questions), q.Text, time.Second/2).
+			Write("  1) %s\n  2) %s\n", q.Choices[0], q.Choices[1])
+		// The prompt is shown even when the output isn't a terminal, as the answers are part of the demo.
+		d.Write("-> ", time.Duration(0)).Wait().
+			Write("%d\n", choice+1, demoTypingDuration).Wait()
+	}
+
+	return quiz.Evaluate(questions, answers)
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/tmaxmax/mbti/pkg/golden"
+)
+
+// setTerminal makes the command behave as if it wrote to a terminal: the
+// typewriter effect, which is disabled otherwise, is kept so the pacing is
+// recorded, and colors are turned off so the transcript stays readable.
+func setTerminal(t *testing.T) {
+	t.Helper()
+
+	prevInteractive, prevAccessible := interactiveOutput, accessible
+	interactiveOutput, accessible = true, false
+
+	t.Cleanup(func() { interactiveOutput, accessible = prevInteractive, prevAccessible })
+	t.Setenv("NO_COLOR", "1")
+}
+
+func TestDemo(t *testing.T) {
+	setTerminal(t)
+
+	tr, err := golden.Run(runDemo, []string{"-seed", "1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.Assert(t, "testdata/demo.golden", tr)
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+)
+
+type doctorReport struct {
+	StdinTTY      bool   `json:"stdinTTY"`
+	StdoutTTY     bool   `json:"stdoutTTY"`
+	Width         int    `json:"width"`
+	WidthSource   string `json:"widthSource"`
+	Term          string `json:"term"`
+	Colors        string `json:"colors"`
+	Locale        string `json:"locale"`
+	Accessible    bool   `json:"accessible"`
+	Color         bool   `json:"color"`
+	Typewriter    bool   `json:"typewriter"`
+	CursorControl bool   `json:"cursorControl"`
+	Prompts       bool   `json:"prompts"`
+}
+
+func runDoctor(args []string) error {
+	flags := newFlagSet("doctor")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("%w: doctor takes no arguments", errArguments)
+	}
+
+	width, source := terminalWidth()
+	colors := colorSupport()
+
+	r := doctorReport{
+		StdinTTY:      isTerminal(os.Stdin),
+		StdoutTTY:     interactiveOutput,
+		Width:         width,
+		WidthSource:   source,
+		Term:          os.Getenv("TERM"),
+		Colors:        colors,
+		Locale:        locale(),
+		Accessible:    accessible,
+		Color:         colors != colorNone,
+		Typewriter:    interactiveOutput && !accessible,
+		CursorControl: cursorControl(),
+		Prompts:       interactiveOutput,
+	}
+
+	if outputFormat == formatJSON {
+		return printJSON(r)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Standard input is a terminal\t%s\n", yesNo(r.StdinTTY))
+	fmt.Fprintf(w, "Standard output is a terminal\t%s\n", yesNo(r.StdoutTTY))
+	if r.Width > 0 {
+		fmt.Fprintf(w, "Terminal width\t%d (from %s)\n", r.Width, r.WidthSource)
+	} else {
+		fmt.Fprintf(w, "Terminal width\tunknown\n")
+	}
+	fmt.Fprintf(w, "TERM\t%s\n", orNone(r.Term))
+	fmt.Fprintf(w, "Color support\t%s\n", r.Colors)
+	fmt.Fprintf(w, "Locale\t%s\n", r.Locale)
+	fmt.Fprintf(w, "Accessible mode\t%s\n", yesNo(r.Accessible))
+	fmt.Fprintf(w, "\nEffects:\n")
+	fmt.Fprintf(w, "  Color\t%s\n", enabled(r.Color))
+	fmt.Fprintf(w, "  Typewriter\t%s\n", enabled(r.Typewriter))
+	fmt.Fprintf(w, "  Cursor control\t%s\n", enabled(r.CursorControl))
+	fmt.Fprintf(w, "  Prompts\t%s\n", enabled(r.Prompts))
+
+	return w.Flush()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+
+	return "no"
+}
+
+func enabled(b bool) string {
+	if b {
+		return "enabled"
+	}
+
+	return "disabled"
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "(not set)"
+	}
+
+	return s
+}
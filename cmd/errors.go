@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// Exit codes of the program.
+const (
+	exitOK           = 0
+	exitUsage        = 1
+	exitInvalidInput = 2
+	exitInternal     = 3
+)
+
+var (
+	errUnknownCommand = errors.New("unknown command")
+	errUnknownFormat  = errors.New("unknown output format")
+)
+
+// usageError marks errors caused by wrong usage of the command line.
+type usageError struct {
+	err error
+	// flags is the flag set whose usage is printed with the error, if any.
+	flags *flag.FlagSet
+}
+
+func (e *usageError) Error() string {
+	return e.err.Error()
+}
+
+func (e *usageError) Unwrap() error {
+	return e.err
+}
+
+// usageErrors are the errors caused by wrong usage of the command line.
+var usageErrors = []error{errArguments, errNoQuery, errNoSuchEntry, errUnknownRateLimitKey, errUnknownCommand, errUnknownFormat, errNoMatchingType, errUnknownColumn, errUnknownSessionStore}
+
+// inputErrors are the errors caused by invalid user input.
+var inputErrors = []error{mbti.ErrInvalidInput, mbti.ErrInvalidIndicatorString, mbti.ErrInvalidFunction, mbti.ErrInvalidFunctions, mbti.ErrInvalidFunctionsString, mbti.ErrInvalidNotation, mbti.ErrUnknownRelation, mbti.ErrUnknownModel, errValidationFailed, errInvalidTemplate, errInvalidQuestions}
+
+func isAny(err error, targets []error) bool {
+	for _, t := range targets {
+		if errors.Is(err, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// exitCode classifies the error returned by a command.
+func exitCode(err error) int {
+	var usage *usageError
+
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &usage), isAny(err, usageErrors):
+		return exitUsage
+	case isAny(err, inputErrors):
+		return exitInvalidInput
+	default:
+		return exitInternal
+	}
+}
+
+var errorTypes = map[int]string{
+	exitUsage:        "usage",
+	exitInvalidInput: "invalid_input",
+	exitInternal:     "internal",
+}
+
+type errorEnvelope struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// reportError writes the error in the selected output format and returns the exit code.
+func reportError(w io.Writer, err error) int {
+	code := exitCode(err)
+	if code == exitOK {
+		return code
+	}
+
+	if outputFormat == formatJSON {
+		var e errorEnvelope
+		e.Error.Code = code
+		e.Error.Type = errorTypes[code]
+		e.Error.Message = err.Error()
+
+		_ = json.NewEncoder(w).Encode(e)
+	} else {
+		fmt.Fprintln(w, "Error:", err)
+
+		var usage *usageError
+		if errors.As(err, &usage) && usage.flags != nil {
+			usage.flags.SetOutput(w)
+			usage.flags.Usage()
+		}
+	}
+
+	return code
+}
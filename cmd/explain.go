@@ -0,0 +1,25 @@
+package main
+
+import (
+	"time"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/delayed"
+)
+
+// queueMind queues on d the operations that print the given personality's mind.
+func queueMind(d *delayed.Delayed, ego *mbti.Personality) *delayed.Delayed {
+	m := mbti.NewMind(ego)
+
+	if accessible {
+		return d.Write("Ego: %s. Functions: %s.\n", m.Ego, describeFunctions(m.Ego.Functions())).
+			Write("Unconscious: %s. Functions: %s.\n", m.Unconscious, describeFunctions(m.Unconscious.Functions())).
+			Write("Subconscious: %s. Functions: %s.\n", m.Subconscious, describeFunctions(m.Subconscious.Functions())).
+			Write("Super-ego: %s. Functions: %s.\n\n", m.SuperEgo, describeFunctions(m.SuperEgo.Functions()))
+	}
+
+	return d.Write("Ego: %s (%s)\n", m.Ego, formatFunctions(m.Ego.Functions()), time.Second).Wait().
+		Write("Unconscious: %s (%s)\n", m.Unconscious, formatFunctions(m.Unconscious.Functions())).Wait().
+		Write("Subconscious: %s (%s)\n", m.Subconscious, formatFunctions(m.Subconscious.Functions())).Wait().
+		Write("Super-ego: %s (%s)\n\n", m.SuperEgo, formatFunctions(m.SuperEgo.Functions())).Wait()
+}
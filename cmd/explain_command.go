@@ -0,0 +1,259 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/internal/api"
+)
+
+var errArguments = errors.New("wrong number of arguments")
+
+// addDiagramFlags adds the flags selecting whether diagrams are printed
+// alongside or instead of the textual listing.
+func addDiagramFlags(flags *flag.FlagSet) (diagram, diagramOnly *bool) {
+	diagram = flags.Bool("diagram", false, "Print an ASCII diagram alongside the textual listing")
+	diagramOnly = flags.Bool("diagram-only", false, "Print an ASCII diagram instead of the textual listing")
+
+	return
+}
+
+func runExplain(args []string) error {
+	flags := newFlagSet("explain")
+	diagram, diagramOnly := addDiagramFlags(flags)
+	flow := flags.Bool("flow", false, "Print a flowchart of how the functions are derived from the indicator")
+	shadow := flags.Bool("shadow", false, "Print all eight functions with their roles, including the shadow")
+	socionics := flags.Bool("socionics", false, "Print the socionics codes and the functions in the positions of Model A")
+	templatePath := addTemplateFlag(flags, "the mind of each personality (.Ego, .Unconscious, .Subconscious, .SuperEgo)")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	tmpl, err := loadTemplate(*templatePath)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() == 0 {
+		return fmt.Errorf("%w: expected type indicators or dominant functions", errArguments)
+	}
+
+	var egos []*mbti.Personality
+
+	for _, input := range flags.Args() {
+		ego, err := parseInput(input)
+		if err == nil {
+			egos = append(egos, ego)
+		} else if matches, partialErr := mbti.FromPartialIndicator(input); partialErr == nil {
+			// Partial indicators, such as IN??, explain all the types they match.
+			egos = append(egos, matches...)
+		} else {
+			return err
+		}
+	}
+
+	for _, input := range flags.Args() {
+		recordHistory("explain", input)
+	}
+
+	if tmpl == nil && outputFormat == formatJSON {
+		minds := make([]api.Mind, 0, len(egos))
+		for _, ego := range egos {
+			minds = append(minds, api.NewMind(mbti.NewMind(ego)))
+		}
+
+		return printJSON(minds)
+	}
+
+	for i, ego := range egos {
+
+		if tmpl != nil {
+			if err := executeTemplate(tmpl, mbti.NewMind(ego)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if i > 0 && *diagramOnly {
+			fmt.Println()
+		}
+
+		if !*diagramOnly {
+			if err := <-queueMind(newTypewriter(true, 0, 0), ego).Do(); err != nil {
+				return err
+			}
+		}
+
+		if *diagram || *diagramOnly {
+			fmt.Print(renderStack(ego))
+		}
+
+		if *flow {
+			fmt.Print(renderFlow(ego))
+		}
+
+		if *shadow {
+			printRoles(ego)
+		}
+
+		if *socionics {
+			printModelA(ego)
+		}
+	}
+
+	return nil
+}
+
+// printRoles prints the eight-function stack of the personality.
+func printRoles(p *mbti.Personality) {
+	fmt.Printf("Roles of %s:\n", p)
+
+	for _, e := range p.Stack() {
+		fmt.Printf("  %-9s %s (%s)\n", e.Role, e.Function, e.Function.Description().Name)
+	}
+
+	fmt.Println()
+}
+
+// printModelA prints the socionics codes of the personality and its functions in Model A.
+func printModelA(p *mbti.Personality) {
+	fmt.Printf("Socionics: %s (%s)\n", p.Socionics(), p.SocionicsFourLetter())
+
+	for i, fn := range p.ModelAFunctions() {
+		fmt.Printf("  %d %-13s %s (%s)\n", i+1, mbti.ModelAPositions[i], fn, fn.Description().Name)
+	}
+
+	fmt.Println()
+}
+
+func runCompare(args []string) error {
+	flags := newFlagSet("compare")
+	diagram, diagramOnly := addDiagramFlags(flags)
+	templatePath := addTemplateFlag(flags, "the comparison (.A, .B, .SharedFunctions, .SamePosition)")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	tmpl, err := loadTemplate(*templatePath)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 2 {
+		return fmt.Errorf("%w: expected two personalities to compare", errArguments)
+	}
+
+	a, err := parseInput(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	b, err := parseInput(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	recordHistory("compare", flags.Arg(0)+" "+flags.Arg(1))
+
+	c := mbti.Compare(a, b)
+
+	d, o := mbti.Distance(a, b), mbti.FunctionOverlap(a, b)
+
+	switch {
+	case tmpl != nil:
+		return executeTemplate(tmpl, c)
+	case outputFormat == formatJSON:
+		return printJSON(compareJSON{
+			Comparison:      api.NewComparison(c),
+			Distance:        d.Score,
+			LetterDistance:  d.Letters,
+			StackDistance:   d.Stack,
+			FunctionOverlap: o.Score,
+		})
+	case outputFormat == formatTable:
+		return printComparisonTable(os.Stdout, a, b)
+	}
+
+	if !*diagramOnly {
+		fmt.Printf("%s (%s)\n%s (%s)\n", a, formatFunctions(a.Functions()), b, formatFunctions(b.Functions()))
+		fmt.Printf("Shared functions: %s\n", formatFunctionsOrNone(c.SharedFunctions))
+		fmt.Printf("Same position: %s\n", formatFunctionsOrNone(c.SamePosition))
+
+		fmt.Printf("Distance: %.0f%% (%d/4 letters differ, %d stack edits)\n", d.Score*100, d.Letters, d.Stack)
+		fmt.Printf("Function overlap: %.0f%%\n", o.Score*100)
+	}
+
+	if *diagram || *diagramOnly {
+		if !*diagramOnly {
+			fmt.Println()
+		}
+
+		fmt.Print(renderComparison(c))
+	}
+
+	return nil
+}
+
+type compareJSON struct {
+	api.Comparison
+	Distance        float64 `json:"distance"`
+	LetterDistance  int     `json:"letterDistance"`
+	StackDistance   int     `json:"stackDistance"`
+	FunctionOverlap float64 `json:"functionOverlap"`
+}
+
+// printComparisonTable writes the functions of both personalities side by side, by position.
+func printComparisonTable(out io.Writer, a, b *mbti.Personality) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "POSITION\t%s\t%s\n", a, b)
+	aFunctions, bFunctions := a.Functions(), b.Functions()
+	for i, name := range mbti.PositionNames {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", name, aFunctions[i], bFunctions[i])
+	}
+
+	return w.Flush()
+}
+
+// renderStack draws the stack diagram, or describes it in accessible mode.
+func renderStack(p *mbti.Personality) string {
+	if accessible {
+		return describeStack(p)
+	}
+
+	return mbti.RenderStack(p)
+}
+
+// renderFlow draws the derivation flowchart, or describes the derivation in accessible mode.
+func renderFlow(p *mbti.Personality) string {
+	if accessible {
+		return describeFlow(p)
+	}
+
+	return mbti.RenderFlow(p)
+}
+
+// renderComparison draws the comparison diagram, or describes it in accessible mode.
+func renderComparison(c *mbti.Comparison) string {
+	if accessible {
+		return describeComparison(c)
+	}
+
+	return mbti.RenderComparison(c)
+}
+
+func formatFunctionsOrNone(functions []mbti.Function) string {
+	if len(functions) == 0 {
+		return "none"
+	}
+
+	return formatFunctions(functions)
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/tmaxmax/mbti/pkg/golden"
+)
+
+func TestExplain(t *testing.T) {
+	setTerminal(t)
+	t.Setenv("MBTI_HISTORY", t.TempDir()+"/history.jsonl")
+
+	tr, err := golden.Run(runExplain, []string{"INTJ"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	golden.Assert(t, "testdata/explain.golden", tr)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// filterPattern matches the inputs the filter recognizes in free text: uppercase
+// indicators, optionally with an identity suffix, and function pairs written
+// with an uppercase kind and a lowercase focus, so common words aren't matched.
+var filterPattern = regexp.MustCompile(`\b(?:[EI][NS][TF][JP](?:-[AT])?|[NSTF][ie][/-]?[NSTF][ie])\b`)
+
+// runFilter copies the input to the output line by line, annotating every
+// type it finds with its other notation: indicators with their functions
+// and function pairs with their indicator. If replace is true, types are
+// replaced instead of annotated.
+func runFilter(in io.Reader, out io.Writer, replace bool) error {
+	s := bufio.NewScanner(in)
+	w := bufio.NewWriter(out)
+
+	for s.Scan() {
+		line := filterPattern.ReplaceAllStringFunc(s.Text(), func(match string) string {
+			p, err := parseInput(match)
+			if err != nil {
+				return match
+			}
+
+			result := p.String()
+			if mbti.IsIndicatorString(match) {
+				result = formatFunctions(p.Functions())
+			}
+
+			if replace {
+				return result
+			}
+
+			return fmt.Sprintf("%s [%s]", match, result)
+		})
+
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+
+		// Flush each line, so the filter can be used interactively and in pipelines.
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return s.Err()
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// Output formats selectable with the -format flag.
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+// commandFormats are the output formats some commands support besides text and JSON.
+var commandFormats = map[string][]string{
+	"matrix":    {formatTable, formatCSV, formatDot},
+	"info":      {formatTable},
+	"list":      {formatTable},
+	"functions": {formatTable},
+	"compare":   {formatTable},
+}
+
+// Output formats supported by some commands only.
+const (
+	formatTable = "table"
+	formatCSV   = "csv"
+	formatDot   = "dot"
+)
+
+// outputFormat is the output format selected with the -format flag.
+var outputFormat = formatText
+
+// modelName is the name of the model selected with the -model flag, and model the model itself.
+var (
+	modelName = mbti.ModelGrant.Name()
+	model     = mbti.ModelGrant
+)
+
+// newFlagSet creates the flag set of a command, with the flags shared by all commands.
+// The flag set doesn't print anything on its own; parse errors are reported by parseFlags.
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	// The current value is the default, so the format can also be given before the command name.
+	flags.StringVar(&outputFormat, "format", outputFormat, "The output format of errors, logs and data: \"text\" or \"json\", the latter only changing errors and logs for interactive commands such as learn, or \"table\" for the info, list, functions, compare and matrix commands, and \"csv\" or \"dot\" for the matrix command")
+	flags.BoolVar(&verbose, "verbose", verbose, "Log informational messages, such as loaded data files, to the standard error")
+	flags.BoolVar(&debug, "debug", debug, "Log debugging messages, such as parsing decisions, to the standard error")
+	flags.BoolVar(&accessible, "accessible", accessible, "Show screen reader friendly output, without animations or styling")
+	flags.BoolVar(&copyOutput, "copy", copyOutput, "Copy the output to the clipboard")
+	flags.StringVar(&inputLocale, "locale", inputLocale, "The locale of the input, whose nicknames and letters are accepted besides English ones, and of the function letters in the text output. Defaults to the locale of the environment")
+	flags.StringVar(&dataDir, "data", dataDir, "A directory of JSON files with metadata merged over the built-in dataset")
+	flags.StringVar(&modelName, "model", modelName, "The model deriving the function stacks of the input types: \"grant\", \"myers\" or \"model-a\"")
+
+	return flags
+}
+
+// parseFlags parses the arguments, reporting failures as usage errors.
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			flags.SetOutput(os.Stderr)
+			flags.Usage()
+		}
+
+		return &usageError{err: err, flags: flags}
+	}
+
+	// The interactive session checks the format once it knows no command name follows.
+	if flags.Name() != "mbti" && !isFormatSupported(flags.Name(), outputFormat) {
+		return &usageError{err: fmt.Errorf("%w %q", errUnknownFormat, outputFormat), flags: flags}
+	}
+
+	configureLogging(slog.LevelWarn)
+
+	m, err := mbti.LookupModel(modelName)
+	if err != nil {
+		return &usageError{err: err, flags: flags}
+	}
+
+	model = m
+
+	if settingsErr != nil {
+		slog.Warn("failed to load configuration", "err", settingsErr)
+		settingsErr = nil
+	}
+
+	if copyOutput {
+		if err := startCopy(); err != nil {
+			return err
+		}
+	}
+
+	if dataDir != "" && !dataLoaded {
+		if err := loadMetadataOverlays(dataDir); err != nil {
+			return err
+		}
+
+		dataLoaded = true
+	}
+
+	return nil
+}
+
+// parseInterspersedFlags is parseFlags for commands whose flags may also follow
+// their arguments, as in "mbti info INTJ -format json". Arguments after "--"
+// are never flags.
+func parseInterspersedFlags(flags *flag.FlagSet, args []string) error {
+	var flagArgs, positional []string
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == "--" {
+			positional = append(positional, args[i+1:]...)
+
+			break
+		}
+
+		if len(arg) < 2 || arg[0] != '-' {
+			positional = append(positional, arg)
+
+			continue
+		}
+
+		flagArgs = append(flagArgs, arg)
+
+		// The value of a non-boolean flag may be the next argument.
+		name := strings.TrimLeft(arg, "-")
+		if strings.Contains(name, "=") {
+			continue
+		}
+
+		if f := flags.Lookup(name); f != nil && !isBoolFlag(f) && i+1 < len(args) {
+			i++
+			flagArgs = append(flagArgs, args[i])
+		}
+	}
+
+	return parseFlags(flags, append(append(flagArgs, "--"), positional...))
+}
+
+func isBoolFlag(f *flag.Flag) bool {
+	b, ok := f.Value.(interface{ IsBoolFlag() bool })
+
+	return ok && b.IsBoolFlag()
+}
+
+func isFormatSupported(command, format string) bool {
+	if format == formatText || format == formatJSON {
+		return true
+	}
+
+	for _, f := range commandFormats[command] {
+		if f == format {
+			return true
+		}
+	}
+
+	return false
+}
+
+// printJSON writes the value to the standard output as indented JSON.
+func printJSON(v interface{}) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tmaxmax/mbti"
+)
+
+func runFunctions(args []string) error {
+	flags := newFlagSet("functions")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: expected cognitive functions, such as Ni or FeNi", errArguments)
+	}
+
+	input := strings.TrimSpace(flags.Arg(0))
+	if mbti.FunctionCountInString(input) == 0 {
+		return fmt.Errorf("%w %q", mbti.ErrInvalidFunctionsString, input)
+	}
+
+	functions, err := mbti.FunctionsFromString(input)
+	if err != nil {
+		return err
+	}
+
+	recordHistory("functions", input)
+
+	switch outputFormat {
+	case formatJSON:
+		ret := make([]functionJSON, 0, len(functions))
+		for _, fn := range functions {
+			ret = append(ret, newFunctionJSON(fn))
+		}
+
+		return printJSON(ret)
+	case formatTable:
+		return printFunctionsTable(os.Stdout, functions)
+	}
+
+	for i, fn := range functions {
+		if i > 0 {
+			fmt.Println()
+		}
+
+		desc := fn.Description()
+
+		fmt.Printf("%s (%s)\n%s\n\n", fn, desc.Name, desc.Summary)
+		fmt.Printf("Axis partner: %s\n", fn.AxisPartner())
+		fmt.Printf("Leading types: %s\n\n", strings.Join(leadingTypes(fn), ", "))
+
+		for i, text := range desc.Positions {
+			fmt.Printf("%s: %s\n", mbti.PositionNames[i], text)
+		}
+	}
+
+	return nil
+}
+
+type functionJSON struct {
+	Function     string            `json:"function"`
+	Name         string            `json:"name"`
+	Summary      string            `json:"summary"`
+	AxisPartner  string            `json:"axisPartner"`
+	LeadingTypes []string          `json:"leadingTypes"`
+	Positions    map[string]string `json:"positions"`
+}
+
+func newFunctionJSON(fn mbti.Function) functionJSON {
+	desc := fn.Description()
+
+	positions := make(map[string]string, len(desc.Positions))
+	for i, text := range desc.Positions {
+		positions[strings.ToLower(mbti.PositionNames[i])] = text
+	}
+
+	return functionJSON{
+		Function:     fn.String(),
+		Name:         desc.Name,
+		Summary:      desc.Summary,
+		AxisPartner:  fn.AxisPartner().String(),
+		LeadingTypes: leadingTypes(fn),
+		Positions:    positions,
+	}
+}
+
+// printFunctionsTable writes a row for each function.
+func printFunctionsTable(out io.Writer, functions []mbti.Function) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "FUNCTION\tNAME\tAXIS PARTNER\tLEADING TYPES\n")
+	for _, fn := range functions {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", fn, fn.Description().Name, fn.AxisPartner(), strings.Join(leadingTypes(fn), " "))
+	}
+
+	return w.Flush()
+}
+
+// leadingTypes returns the indicators of the personality types with the given dominant function.
+func leadingTypes(fn mbti.Function) []string {
+	var ret []string
+
+	for _, p := range mbti.All() {
+		if p.Functions()[mbti.PositionDominant] == fn {
+			ret = append(ret, p.String())
+		}
+	}
+
+	return ret
+}
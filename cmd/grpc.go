@@ -0,0 +1,95 @@
+//go:build grpc
+// +build grpc
+
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/tmaxmax/mbti/pkg/rpc"
+	"github.com/tmaxmax/mbti/quiz"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func init() {
+	commands = append(commands, &command{name: "grpc", description: "Start a gRPC server exposing the personality service", run: runGRPC})
+}
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mbti",
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "mbti",
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of gRPC requests by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+func instrumentGRPC(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+
+	grpcRequestDuration.WithLabelValues(info.FullMethod).Observe(time.Since(start).Seconds())
+	grpcRequests.WithLabelValues(info.FullMethod, status.Code(err).String()).Inc()
+	slog.Info("request", "method", info.FullMethod, "code", status.Code(err).String(), "duration", time.Since(start))
+
+	switch resp := resp.(type) {
+	case *rpc.Mind:
+		typeQueries.WithLabelValues(resp.GetEgo().GetIndicator()).Inc()
+	case *rpc.Comparison:
+		typeQueries.WithLabelValues(resp.GetA().GetIndicator()).Inc()
+		typeQueries.WithLabelValues(resp.GetB().GetIndicator()).Inc()
+	case *rpc.QuizResult:
+		quizCompletions.WithLabelValues(resp.GetIndicator()).Inc()
+	}
+
+	return resp, err
+}
+
+func runGRPC(args []string) error {
+	flags := newFlagSet("grpc")
+	addr := flags.String("addr", ":9090", "The address the gRPC server listens on")
+	metricsAddr := flags.String("metrics-addr", "", "The address metrics are served on at /metrics. Metrics aren't served if empty")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	configureLogging(slog.LevelInfo)
+
+	if *metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metricsHandler())
+
+		go func() {
+			slog.Info("serving metrics", "addr", *metricsAddr)
+			slog.Error("metrics server stopped", "err", http.ListenAndServe(*metricsAddr, mux))
+		}()
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(instrumentGRPC))
+	rpc.RegisterPersonalityServiceServer(s, rpc.NewServer(quiz.DefaultQuestions, model))
+
+	slog.Info("listening", "addr", *addr)
+
+	return s.Serve(lis)
+}
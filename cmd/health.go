@@ -0,0 +1,90 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/tmaxmax/mbti"
+)
+
+type healthJSON struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+var (
+	errDraining    = errors.New("server is shutting down")
+	errNoQuestions = errors.New("no quiz questions loaded")
+)
+
+// drain marks the server as not ready, so load balancers stop routing new
+// requests to it while in-flight ones finish.
+func (s *apiServer) drain() {
+	atomic.StoreInt32(&s.draining, 1)
+}
+
+func checkPersonalities() error {
+	all := mbti.All()
+	if len(all) != 16 {
+		return fmt.Errorf("expected 16 personality types, got %d", len(all))
+	}
+
+	for _, p := range all {
+		if _, err := mbti.Parse(p.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *apiServer) checkQuestions() error {
+	if len(s.questions) == 0 {
+		return errNoQuestions
+	}
+
+	return nil
+}
+
+func handleHealthz(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, healthJSON{Status: "ok"})
+}
+
+func (s *apiServer) handleReadyz(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+		return
+	}
+
+	checks := map[string]func() error{
+		"personalities": checkPersonalities,
+		"questions":     s.checkQuestions,
+		"shutdown": func() error {
+			if atomic.LoadInt32(&s.draining) == 1 {
+				return errDraining
+			}
+
+			return nil
+		},
+	}
+
+	ret := healthJSON{Status: "ok", Checks: make(map[string]string, len(checks))}
+	status := http.StatusOK
+
+	for name, check := range checks {
+		if err := check(); err != nil {
+			ret.Checks[name] = err.Error()
+			ret.Status = "unavailable"
+			status = http.StatusServiceUnavailable
+		} else {
+			ret.Checks[name] = "ok"
+		}
+	}
+
+	writeJSON(w, status, ret)
+}
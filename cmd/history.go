@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// historyEntry is a query made using the interactive session or a command.
+type historyEntry struct {
+	Time time.Time `json:"time"`
+	// Command is the command the query was made with, or empty for the interactive session.
+	Command string `json:"command,omitempty"`
+	Query   string `json:"query"`
+}
+
+// historyPath returns the path of the history file, which can be set
+// using the MBTI_HISTORY environment variable.
+func historyPath() (string, error) {
+	if path := os.Getenv("MBTI_HISTORY"); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "mbti", "history.jsonl"), nil
+}
+
+// recordHistory appends a query to the history file. Failures are only
+// logged, as the history is a convenience.
+func recordHistory(command, query string) {
+	if err := appendHistory(historyEntry{Time: time.Now(), Command: command, Query: query}); err != nil {
+		slog.Warn("failed to record history", "err", err)
+	}
+}
+
+func appendHistory(e historyEntry) error {
+	path, err := historyPath()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	data, _ := json.Marshal(e)
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		_ = f.Close()
+
+		return err
+	}
+
+	return f.Close()
+}
+
+func loadHistory() ([]historyEntry, error) {
+	path, err := historyPath()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []historyEntry
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		var e historyEntry
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			// Skip lines corrupted by interrupted writes.
+			continue
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, s.Err()
+}
+
+var errNoSuchEntry = errors.New("no such history entry")
+
+func runHistory(args []string) error {
+	flags := newFlagSet("history")
+	filter := flags.String("filter", "", "Only show queries containing the given text")
+	command := flags.String("command", "", "Only show queries made with the given command (\"interactive\" for the interactive session)")
+	limit := flags.Int("limit", 20, "Show only the most recent entries. All entries are shown if 0")
+	rerun := flags.Int("rerun", 0, "Run again the query with the given number")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	entries, err := loadHistory()
+	if err != nil {
+		return err
+	}
+
+	if *rerun != 0 {
+		if *rerun < 1 || *rerun > len(entries) {
+			return fmt.Errorf("%w %d", errNoSuchEntry, *rerun)
+		}
+
+		return rerunHistoryEntry(entries[*rerun-1])
+	}
+
+	type numbered struct {
+		n int
+		historyEntry
+	}
+
+	var shown []numbered
+
+	for i, e := range entries {
+		name := e.Command
+		if name == "" {
+			name = "interactive"
+		}
+
+		if *command != "" && name != *command {
+			continue
+		}
+
+		if *filter != "" && !strings.Contains(strings.ToLower(e.Query), strings.ToLower(*filter)) {
+			continue
+		}
+
+		shown = append(shown, numbered{n: i + 1, historyEntry: historyEntry{Time: e.Time, Command: name, Query: e.Query}})
+	}
+
+	if *limit > 0 && len(shown) > *limit {
+		shown = shown[len(shown)-*limit:]
+	}
+
+	for _, e := range shown {
+		fmt.Printf("%5d  %s  %-11s %s\n", e.n, e.Time.Local().Format("2006-01-02 15:04"), e.Command, e.Query)
+	}
+
+	return nil
+}
+
+func rerunHistoryEntry(e historyEntry) error {
+	switch e.Command {
+	case "":
+		ego, err := parseInput(e.Query)
+		if err != nil {
+			return err
+		}
+
+		return <-queueMind(newTypewriter(true, 0, 0), ego).Do()
+	case "search":
+		return runSearch([]string{e.Query})
+	case "explain":
+		return runExplain([]string{e.Query})
+	case "compare":
+		return runCompare(strings.Fields(e.Query))
+	case "functions":
+		return runFunctions([]string{e.Query})
+	case "relate":
+		return runRelate(strings.Fields(e.Query))
+	case "match":
+		return runMatch([]string{e.Query})
+	default:
+		return fmt.Errorf("can't rerun queries made with %q", e.Command)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/internal/api"
+)
+
+type infoJSON struct {
+	Indicator   string   `json:"indicator"`
+	Nickname    string   `json:"nickname,omitempty"`
+	Group       string   `json:"group,omitempty"`
+	Temperament string   `json:"temperament"`
+	Socionics   string   `json:"socionics"`
+	Functions   []string `json:"functions"`
+	Description string   `json:"description,omitempty"`
+}
+
+func newInfoJSON(p *mbti.Personality) infoJSON {
+	md := p.Metadata()
+
+	return infoJSON{
+		Indicator:   p.String(),
+		Nickname:    md.Nickname,
+		Group:       md.Group,
+		Temperament: string(p.Temperament()),
+		Socionics:   p.Socionics(),
+		Functions:   api.FunctionStrings(p.Functions()),
+		Description: md.Description,
+	}
+}
+
+func runInfo(args []string) error {
+	flags := newFlagSet("info")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: expected a personality type", errArguments)
+	}
+
+	p, err := parseInput(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	recordHistory("info", flags.Arg(0))
+
+	switch outputFormat {
+	case formatJSON:
+		return printJSON(newInfoJSON(p))
+	case formatTable:
+		return printInfoTable(os.Stdout, []*mbti.Personality{p})
+	}
+
+	fmt.Print(p)
+	if nickname := p.Nickname(); nickname != "" {
+		fmt.Printf(", the %s", nickname)
+	}
+	fmt.Println()
+
+	if group := p.Metadata().Group; group != "" {
+		fmt.Printf("Group: %s\n", group)
+	}
+
+	fmt.Printf("Temperament: %s (%s)\n", p.Temperament(), p.Temperament().Name())
+	fmt.Printf("Socionics: %s\n", p.Socionics())
+	fmt.Printf("Functions: %s\n", formatFunctions(p.Functions()))
+
+	if description := p.Description(); description != "" {
+		fmt.Printf("\n%s\n", description)
+	}
+
+	return nil
+}
+
+// printInfoTable writes a row with the information of each personality.
+func printInfoTable(out io.Writer, personalities []*mbti.Personality) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "TYPE\tNICKNAME\tGROUP\tTEMPERAMENT\tSOCIONICS\tFUNCTIONS\n")
+	for _, p := range personalities {
+		md := p.Metadata()
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", p, md.Nickname, md.Group, p.Temperament(), p.Socionics(), formatFunctions(p.Functions()))
+	}
+
+	return w.Flush()
+}
+
+func runList(args []string) error {
+	flags := newFlagSet("list")
+	temperament := flags.String("temperament", "", "Only list types of the given temperament: NT, NF, SJ or SP")
+	dominant := flags.String("dominant", "", "Only list types with the given dominant function, such as Ni")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 1 {
+		return fmt.Errorf("%w: expected at most one partial indicator, such as IN??", errArguments)
+	}
+
+	var predicates []mbti.Predicate
+
+	if *temperament != "" {
+		t := mbti.Temperament(strings.ToUpper(*temperament))
+		if !isTemperament(t) {
+			return &usageError{err: fmt.Errorf("%w %q", errUnknownTemperament, *temperament), flags: flags}
+		}
+
+		predicates = append(predicates, mbti.ByTemperament(t))
+	}
+
+	if *dominant != "" {
+		fns, err := mbti.FunctionsFromString(*dominant)
+		if err != nil || len(fns) != 1 {
+			return &usageError{err: fmt.Errorf("%w %q", mbti.ErrInvalidFunctionsString, *dominant), flags: flags}
+		}
+
+		predicates = append(predicates, mbti.ByDominant(fns[0]))
+	}
+
+	if flags.NArg() == 1 {
+		matches, err := mbti.FromPartialIndicator(flags.Arg(0))
+		if err != nil {
+			return err
+		}
+
+		indicators := make(map[string]bool, len(matches))
+		for _, p := range matches {
+			indicators[p.Indicator()] = true
+		}
+
+		predicates = append(predicates, func(p *mbti.Personality) bool { return indicators[p.Indicator()] })
+	}
+
+	personalities := mbti.Filter(predicates...)
+
+	switch outputFormat {
+	case formatJSON:
+		ret := make([]infoJSON, 0, len(personalities))
+		for _, p := range personalities {
+			ret = append(ret, newInfoJSON(p))
+		}
+
+		return printJSON(ret)
+	case formatTable:
+		return printInfoTable(os.Stdout, personalities)
+	}
+
+	for _, p := range personalities {
+		fmt.Println(p)
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+)
+
+func runInteractive(args []string) error {
+	flags := newFlagSet("mbti")
+	instantOutput := flags.Bool("instantOutput", false, "True if you want output to be shown instantly, without a typewriter-like effect")
+	filter := flags.Bool("filter", false, "Copy the standard input to the standard output, annotating the types found on each line")
+	replace := flags.Bool("replace", false, "With -filter, replace the types found instead of annotating them")
+	templatePath := addTemplateFlag(flags, "the mind of each personality (.Ego, .Unconscious, .Subconscious, .SuperEgo)")
+	flags.Usage = usage(flags)
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		// Allow flags shared by all commands to come before the command name.
+		if c := findCommand(flags.Arg(0)); c != nil {
+			return c.run(flags.Args()[1:])
+		}
+
+		return fmt.Errorf("%w %q", errUnknownCommand, flags.Arg(0))
+	}
+
+	if !isFormatSupported(flags.Name(), outputFormat) {
+		return &usageError{err: fmt.Errorf("%w %q", errUnknownFormat, outputFormat), flags: flags}
+	}
+
+	if *filter {
+		return runFilter(os.Stdin, os.Stdout, *replace)
+	}
+
+	tmpl, err := loadTemplate(*templatePath)
+	if err != nil {
+		return err
+	}
+
+	d := newTypewriter(*instantOutput, time.Second, time.Second/2)
+	in := bufio.NewScanner(os.Stdin)
+
+	for {
+		if interactiveOutput {
+			<-d.Write("Input dominant functions (e.g. FeNi) or a Myers-Briggs type indicator, or type \"exit\" to close the program.\n").
+				Write("-> ", time.Duration(0)).
+				Do()
+		}
+
+		if !in.Scan() {
+			if err := in.Err(); err != nil {
+				return fmt.Errorf("input error: %w", err)
+			}
+
+			return nil
+		}
+
+		inputs := mbti.Fields(localizeLine(in.Text()))
+		if len(inputs) == 1 && inputs[0] == "exit" {
+			return nil
+		}
+
+		for _, input := range inputs {
+			ego, err := parseInput(input)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %s\n\n", err)
+
+				continue
+			}
+
+			recordHistory("", input)
+
+			if tmpl != nil {
+				if err := executeTemplate(tmpl, mbti.NewMind(ego)); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			<-queueMind(d, ego).Do()
+		}
+	}
+}
+
+func usage(flags *flag.FlagSet) func() {
+	return func() {
+		out := flags.Output()
+
+		fmt.Fprintf(out, "Usage: mbti [command] [flags]\n\nCommands:\n")
+		for _, c := range commands {
+			fmt.Fprintf(out, "  %-10s %s\n", c.name, c.description)
+		}
+
+		fmt.Fprintf(out, "\nWithout a command, mbti starts an interactive session.\n\nFlags:\n")
+		flags.PrintDefaults()
+	}
+}
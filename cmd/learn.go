@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/delayed"
+)
+
+// defaultLearnExample is the type used as example in the tutorial, if none is given.
+const defaultLearnExample = "INTJ"
+
+// lesson is a section of the tutorial, followed by a question checking it was understood.
+// The queued text of a lesson ends with an empty line.
+type lesson struct {
+	title    string
+	queue    func(d *delayed.Delayed, p *mbti.Personality) *delayed.Delayed
+	question func(p *mbti.Personality) (text string, choices []string, answer int, explanation string)
+}
+
+var lessons = []lesson{
+	{title: "Cognitive functions", queue: queueFunctionsLesson, question: functionsQuestion},
+	{title: "Function stacks", queue: queueStackLesson, question: stackQuestion},
+	{title: "The four sides of the mind", queue: queueMindLesson, question: mindQuestion},
+}
+
+func runLearn(args []string) error {
+	flags := newFlagSet("learn")
+	instantOutput := flags.Bool("instantOutput", false, "True if you want output to be shown instantly, without a typewriter-like effect")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 1 {
+		return fmt.Errorf("%w: expected at most one example personality type", errArguments)
+	}
+
+	input := defaultLearnExample
+	if flags.NArg() == 1 {
+		input = flags.Arg(0)
+	}
+
+	p, err := parseInput(input)
+	if err != nil {
+		return err
+	}
+
+	d := newTypewriter(*instantOutput, time.Second/2, time.Second)
+	in := bufio.NewScanner(os.Stdin)
+
+	<-d.Write("Welcome! This tutorial explains how personality types work, using %s as an example.\n", p).Wait().Do()
+
+	correct := 0
+
+	for i, l := range lessons {
+		<-l.queue(d.Write("\n%d. %s\n\n", i+1, l.title), p).Do()
+
+		text, choices, answer, explanation := l.question(p)
+
+		choice, err := askChoice(d, in, text, choices)
+		if err != nil {
+			return err
+		}
+
+		if choice == answer {
+			correct++
+			d.Write("Correct! ")
+		} else {
+			d.Write("Not quite. ")
+		}
+
+		<-d.Write("%s\n", explanation).Wait().Do()
+	}
+
+	<-d.Write("\nYou answered %d of %d questions correctly. Try `mbti explain %s` to see more.\n", correct, len(lessons), p).Do()
+
+	return nil
+}
+
+// askChoice asks a multiple choice question and returns the index of the chosen answer.
+func askChoice(d *delayed.Delayed, in *bufio.Scanner, text string, choices []string) (int, error) {
+	d.Write("%s\n", text)
+	for i, c := range choices {
+		if accessible {
+			d.Write("Choice %d: %s.\n", i+1, c)
+		} else {
+			d.Write("  %d) %s\n", i+1, c)
+		}
+	}
+
+	<-prompt(d, "-> ").Do()
+
+	for {
+		if !in.Scan() {
+			if err := in.Err(); err != nil {
+				return 0, fmt.Errorf("input error: %w", err)
+			}
+
+			return 0, fmt.Errorf("input error: %w", io.ErrUnexpectedEOF)
+		}
+
+		if choice, err := strconv.Atoi(strings.TrimSpace(in.Text())); err == nil && choice >= 1 && choice <= len(choices) {
+			return choice - 1, nil
+		}
+
+		<-prompt(d.Write("Please answer with a number from 1 to %d.\n", len(choices)), "-> ").Do()
+	}
+}
+
+func queueFunctionsLesson(d *delayed.Delayed, p *mbti.Personality) *delayed.Delayed {
+	d.Write("Each type uses four cognitive functions. A function is either perceiving,\n").
+		Write("which is how you take in information (Sensing or iNtuition), or judging,\n").
+		Write("which is how you make decisions (Thinking or Feeling).\n").Wait().
+		Write("Each function is also introverted (i) or extraverted (e): focused on\n").
+		Write("the inner world or on the outer one.\n\n").Wait()
+
+	for _, fn := range p.Functions() {
+		d.Write("  %s: %s\n", fn, fn.Description().Name)
+	}
+
+	return d.Write("\n").Wait()
+}
+
+func functionsQuestion(p *mbti.Personality) (string, []string, int, string) {
+	fn := p.Functions()[mbti.PositionAuxiliary]
+
+	kind, answer := "judging", 1
+	if fn.IsProspecting() {
+		kind, answer = "perceiving", 0
+	}
+
+	return fmt.Sprintf("Is %s a perceiving or a judging function?", fn),
+		[]string{"Perceiving", "Judging"},
+		answer,
+		fmt.Sprintf("%s is %s: it is %s.", fn, kind, fn.Description().Name)
+}
+
+func queueStackLesson(d *delayed.Delayed, p *mbti.Personality) *delayed.Delayed {
+	fns := p.Functions()
+
+	d.Write("The functions of a type are ordered in a stack, from the most developed to the least.\n").Wait()
+
+	for i, fn := range fns {
+		d.Write("  %s: %s\n", mbti.PositionNames[i], fn)
+	}
+
+	return d.Wait().
+		Write("\nThe dominant and auxiliary functions have opposite attitudes. The tertiary\n").
+		Write("function is the opposite of the auxiliary, and the inferior one is the\n").
+		Write("opposite of the dominant.\n\n").Wait()
+}
+
+func stackQuestion(p *mbti.Personality) (string, []string, int, string) {
+	fns := p.Functions()
+	inferior := fns[mbti.PositionInferior]
+
+	return fmt.Sprintf("%s leads with %s. Which is its inferior function?", p, fns[mbti.PositionDominant]),
+		[]string{fns[mbti.PositionTertiary].String(), inferior.String()},
+		1,
+		fmt.Sprintf("The inferior function is the opposite of the dominant %s: %s.", fns[mbti.PositionDominant], inferior)
+}
+
+func queueMindLesson(d *delayed.Delayed, p *mbti.Personality) *delayed.Delayed {
+	d.Write("The four functions of a type are only its ego. The other four functions\n").
+		Write("form the other sides of the mind, each being a type on its own.\n").Wait()
+
+	return queueMind(d.Write("\n"), p)
+}
+
+func mindQuestion(p *mbti.Personality) (string, []string, int, string) {
+	m := mbti.NewMind(p)
+
+	return fmt.Sprintf("Which type is the unconscious of %s?", p),
+		[]string{m.SuperEgo.String(), m.Unconscious.String()},
+		1,
+		fmt.Sprintf("The unconscious of %s is %s, which uses the opposite functions in the same order: %s.", p, m.Unconscious, formatFunctions(m.Unconscious.Functions()))
+}
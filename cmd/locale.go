@@ -0,0 +1,33 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// inputLocale is the locale of the input and of the function letters in
+// the text output, set with the -locale flag.
+var inputLocale = locale()
+
+// activeLocale returns the locale of the input, if it is known.
+func activeLocale() (mbti.Locale, bool) {
+	return mbti.LookupLocale(inputLocale)
+}
+
+// localizeLine translates each word of a line of input, so that localized
+// function pairs written with a space, such as "Ни Те", are kept together
+// by mbti.Fields.
+func localizeLine(line string) string {
+	l, ok := activeLocale()
+	if !ok {
+		return line
+	}
+
+	words := strings.Fields(line)
+	for i, w := range words {
+		words[i] = l.Translate(w)
+	}
+
+	return strings.Join(words, " ")
+}
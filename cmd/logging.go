@@ -0,0 +1,106 @@
+package main
+
+import (
+	"log/slog"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// verbose and debug select the logging level, set with the -verbose and -debug flags.
+var verbose, debug bool
+
+var logLevel = new(slog.LevelVar)
+
+// configureLogging makes the default logger write to the standard error,
+// in the selected output format. Only messages of the given level or above
+// are logged, unless a lower level is selected with -verbose or -debug.
+func configureLogging(level slog.Level) {
+	switch {
+	case debug:
+		level = slog.LevelDebug
+	case verbose && level > slog.LevelInfo:
+		level = slog.LevelInfo
+	}
+
+	logLevel.Set(level)
+
+	opts := &slog.HandlerOptions{Level: logLevel}
+
+	var h slog.Handler = slog.NewTextHandler(os.Stderr, opts)
+	if outputFormat == formatJSON {
+		h = slog.NewJSONHandler(os.Stderr, opts)
+	}
+
+	slog.SetDefault(slog.New(h))
+}
+
+// parseInput parses a personality, logging how the input was interpreted.
+func parseInput(input string) (*mbti.Personality, error) {
+	// as is the form the input was parsed as.
+	as := "mbti"
+
+	p, err := mbti.Parse(input)
+	if l, ok := activeLocale(); ok && err != nil {
+		p, err = mbti.ParseLocalized(input, l)
+		if err == nil {
+			as = "localized"
+			slog.Debug("parsed localized input", "input", input, "locale", l.Tag, "translated", l.Translate(input))
+		}
+	}
+
+	if err != nil {
+		if s, socionicsErr := mbti.FromSocionics(input); socionicsErr == nil {
+			p, err, as = s, nil, "socionics"
+		}
+	}
+
+	if err != nil {
+		slog.Debug("parse failed", "input", input, "suggestions", mbti.Suggest(input))
+
+		return nil, err
+	}
+
+	p = p.WithModel(model)
+
+	slog.Debug("parsed input", "input", input, "as", as, "type", p.String(), "model", model.Name(), "functions", formatFunctions(p.Functions()))
+
+	return p, nil
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Flush implements http.Flusher, which the event stream relies on.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// logRequests logs every request handled by h.
+func logRequests(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		info := &requestInfo{}
+
+		h.ServeHTTP(rec, r.WithContext(withRequestInfo(r.Context(), info)))
+
+		attrs := []interface{}{"method", r.Method, "path", r.URL.Path, "status", rec.status, "duration", time.Since(start), "remote", r.RemoteAddr}
+		if info.apiKey != nil {
+			attrs = append(attrs, "api_key", info.apiKey.Name)
+		}
+
+		slog.Info("request", attrs...)
+	})
+}
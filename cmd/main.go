@@ -1,18 +1,52 @@
 package main
 
 import (
-	"errors"
-	"flag"
-	"fmt"
-	"log"
 	"os"
-	"strings"
-	"time"
+)
 
-	"github.com/tmaxmax/mbti"
+type command struct {
+	name        string
+	description string
+	run         func(args []string) error
+}
 
-	"github.com/tmaxmax/mbti/pkg/delayed"
-)
+var commands = []*command{
+	{name: "character", description: "Sketch a fictional character of a given or random personality type", run: runCharacter},
+	{name: "client", description: "Query a server listening on a Unix socket", run: runClient},
+	{name: "convert", description: "Convert types between the notations of different typology communities", run: runConvert},
+	{name: "demo", description: "Play a scripted showcase of the tool, reproducible from a seed", run: runDemo},
+	{name: "doctor", description: "Report the detected terminal capabilities and enabled effects", run: runDoctor},
+	{name: "explain", description: "Show the minds of one or more personality types", run: runExplain},
+	{name: "compare", description: "Compare the function stacks of two personality types", run: runCompare},
+	{name: "functions", description: "Describe one or more cognitive functions", run: runFunctions},
+	{name: "info", description: "Show the nickname, temperament, socionics code and functions of a personality type", run: runInfo},
+	{name: "history", description: "List or rerun previous queries", run: runHistory},
+	{name: "learn", description: "Follow a guided tutorial about cognitive functions and the sides of the mind", run: runLearn},
+	{name: "list", description: "List the personality types, optionally meeting some constraints", run: runList},
+	{name: "match", description: "Rank the other personality types by compatibility", run: runMatch},
+	{name: "matrix", description: "Export the relations between all personality types", run: runMatrix},
+	{name: "mistype", description: "Estimate whether a personality type is a mistype by asking a few questions", run: runMistype},
+	{name: "narrate", description: "Print the text of the standard input with a typewriter effect as it arrives", run: runNarrate},
+	{name: "random", description: "Pick a random personality type, optionally meeting some constraints", run: runRandom},
+	{name: "relate", description: "Describe the relation between two personality types", run: runRelate},
+	{name: "quiz", description: "Find out your personality type by answering a questionnaire", run: runQuiz},
+	{name: "search", description: "Find personality types by nickname, group or description", run: runSearch},
+	{name: "serve", description: "Start an HTTP server exposing the personality API", run: runServe},
+	{name: "stats", description: "Compute the type distributions of a dataset and compare them to the population", run: runStats},
+	{name: "team", description: "Analyze the personality types of a team", run: runTeam},
+	{name: "track", description: "Record personality types over time and show how stable they are", run: runTrack},
+	{name: "validate", description: "Check a file for invalid type indicators and function pairs", run: runValidate},
+}
+
+func findCommand(name string) *command {
+	for _, c := range commands {
+		if c.name == name {
+			return c
+		}
+	}
+
+	return nil
+}
 
 func main() {
 	exitCode := 0
@@ -20,71 +54,20 @@ func main() {
 		os.Exit(exitCode)
 	}()
 
-	instantOutput := flag.Bool("instantOutput", false, "True if you want output to be shown instantly, without a typewriter-like effect")
-
-	flag.Parse()
-
-	d := delayed.New(delayed.Properties{
-		IgnoreDelays:  *instantOutput,
-		PrintDuration: time.Second,
-		WaitDuration:  time.Second / 2,
-	})
+	run := runInteractive
+	args := os.Args[1:]
 
-	for {
-		<-d.Write("Input dominant functions (e.g. FeNi) or a Myers-Briggs type indicator, or type \"exit\" to close the program.\n").
-			Write("-> ", time.Duration(0)).
-			Do()
-
-		var input string
-		_, err := fmt.Scanln(&input)
-		if err != nil {
-			fmt.Println("Input error:", err)
-			exitCode = 1
-
-			return
+	if len(args) > 0 {
+		if c := findCommand(args[0]); c != nil {
+			run = c.run
+			args = args[1:]
 		}
-
-		if input == "exit" {
-			break
-		}
-
-		ego, err := personalityFromInput(input)
-		if err != nil {
-			log.Printf("%s\n\n", err)
-
-			continue
-		}
-
-		unconscious := ego.Unconscious()
-		subconscious := ego.Subconscious()
-		superEgo := ego.SuperEgo()
-
-		<-d.Write("Ego: %s (%s)\n", ego, formatFunctions(ego.Functions()), time.Second).Wait().
-			Write("Unconscious: %s (%s)\n", unconscious, formatFunctions(unconscious.Functions())).Wait().
-			Write("Subconscious: %s (%s)\n", subconscious, formatFunctions(subconscious.Functions())).Wait().
-			Write("Super-ego: %s (%s)\n\n", superEgo, formatFunctions(superEgo.Functions())).Wait().
-			Do()
 	}
-}
-
-func personalityFromInput(input string) (*mbti.Personality, error) {
-	if mbti.FunctionCountInString(input) == 2 {
-		functions, _ := mbti.FunctionsFromString(input)
-
-		return mbti.FromDominantFunctions(functions[0], functions[1])
-	} else if mbti.IsIndicatorString(input) {
-		return mbti.FromIndicator(input)
-	}
-
-	return nil, errors.New("invalid input")
-}
-
-func formatFunctions(functions []mbti.Function) string {
-	representations := make([]string, 0, len(functions))
 
-	for _, fn := range functions {
-		representations = append(representations, fn.String())
+	err := run(args)
+	if copyErr := finishCopy(); err == nil {
+		err = copyErr
 	}
 
-	return strings.Join(representations, " ")
+	exitCode = reportError(os.Stderr, err)
 }
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tmaxmax/mbti"
+)
+
+type matchJSON struct {
+	Rank        int     `json:"rank"`
+	Personality string  `json:"personality"`
+	Score       float64 `json:"score"`
+	// RelationScore and FunctionScore are the two halves of the score.
+	RelationScore float64  `json:"relationScore"`
+	FunctionScore float64  `json:"functionScore"`
+	Relation      string   `json:"relation"`
+	Reasons       []string `json:"reasons"`
+}
+
+func runMatch(args []string) error {
+	flags := newFlagSet("match")
+	top := flags.Int("top", 0, "Only list the N most compatible types. All are listed if 0")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: expected a single personality type", errArguments)
+	}
+
+	if *top < 0 {
+		return fmt.Errorf("%w: -top must not be negative", errArguments)
+	}
+
+	p, err := parseInput(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	recordHistory("match", flags.Arg(0))
+
+	var reports []*mbti.CompatibilityReport
+	for _, other := range mbti.All() {
+		if other.Indicator() != p.Indicator() {
+			reports = append(reports, mbti.Compatibility(p, other))
+		}
+	}
+
+	sort.SliceStable(reports, func(i, j int) bool {
+		return reports[i].Score > reports[j].Score
+	})
+
+	if *top > 0 && *top < len(reports) {
+		reports = reports[:*top]
+	}
+
+	if outputFormat == formatJSON {
+		ret := make([]matchJSON, 0, len(reports))
+		for i, r := range reports {
+			ret = append(ret, matchJSON{Rank: i + 1, Personality: r.B.String(), Score: r.Score, RelationScore: r.RelationScore, FunctionScore: r.StackScore, Relation: r.Relation.String(), Reasons: r.Reasons})
+		}
+
+		return printJSON(ret)
+	}
+
+	for i, r := range reports {
+		fmt.Printf("%2d. %s  %3.0f%%  %s\n", i+1, r.B, r.Score*100, r.Relation)
+		for _, reason := range r.Reasons {
+			fmt.Printf("      - %s\n", reason)
+		}
+	}
+
+	return nil
+}
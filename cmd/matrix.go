@@ -0,0 +1,185 @@
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/tmaxmax/mbti"
+)
+
+func runMatrix(args []string) error {
+	flags := newFlagSet("matrix")
+	relationName := flags.String("relation", "", "Only show the pairs in the given relation, such as \"duality\"")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 0 {
+		return fmt.Errorf("%w: unexpected arguments", errArguments)
+	}
+
+	var only *mbti.RelationType
+	if *relationName != "" {
+		r, err := mbti.ParseRelation(*relationName)
+		if err != nil {
+			return err
+		}
+
+		only = &r
+	}
+
+	m := newRelationMatrix(only)
+
+	switch outputFormat {
+	case formatJSON:
+		return printJSON(m.json())
+	case formatCSV:
+		return m.writeCSV(os.Stdout)
+	case formatDot:
+		return m.writeDot(os.Stdout)
+	default:
+		return m.writeTable(os.Stdout)
+	}
+}
+
+// relationMatrix holds the relation of each type, on the rows, towards each type, on the columns.
+type relationMatrix struct {
+	types     []*mbti.Personality
+	relations [][]mbti.RelationType
+	// only is the relation the matrix is restricted to, if any.
+	only *mbti.RelationType
+}
+
+func newRelationMatrix(only *mbti.RelationType) *relationMatrix {
+	m := &relationMatrix{types: mbti.All(), only: only}
+
+	for _, a := range m.types {
+		row := make([]mbti.RelationType, 0, len(m.types))
+		for _, b := range m.types {
+			row = append(row, mbti.Relationship(a, b))
+		}
+
+		m.relations = append(m.relations, row)
+	}
+
+	return m
+}
+
+// cell returns the name of the relation at the given position, or an empty
+// string if the matrix is restricted to another relation.
+func (m *relationMatrix) cell(i, j int) string {
+	r := m.relations[i][j]
+	if m.only != nil && *m.only != r {
+		return ""
+	}
+
+	return r.String()
+}
+
+func (m *relationMatrix) header() []string {
+	ret := []string{""}
+	for _, p := range m.types {
+		ret = append(ret, p.String())
+	}
+
+	return ret
+}
+
+func (m *relationMatrix) writeTable(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	for _, h := range m.header() {
+		fmt.Fprintf(w, "%s\t", h)
+	}
+	fmt.Fprintln(w)
+
+	for i, a := range m.types {
+		fmt.Fprintf(w, "%s\t", a)
+
+		for j := range m.types {
+			c := m.cell(i, j)
+			if c == "" {
+				c = "-"
+			}
+
+			fmt.Fprintf(w, "%s\t", c)
+		}
+		fmt.Fprintln(w)
+	}
+
+	return w.Flush()
+}
+
+func (m *relationMatrix) writeCSV(out io.Writer) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write(m.header()); err != nil {
+		return err
+	}
+
+	for i, a := range m.types {
+		record := []string{a.String()}
+		for j := range m.types {
+			record = append(record, m.cell(i, j))
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+
+	return w.Error()
+}
+
+// writeDot writes the matrix as a Graphviz graph. Symmetric relations are drawn
+// once per pair, without direction; identity relations are omitted.
+func (m *relationMatrix) writeDot(out io.Writer) error {
+	fmt.Fprintln(out, "digraph relations {")
+
+	for _, p := range m.types {
+		fmt.Fprintf(out, "  %s;\n", p)
+	}
+
+	for i, a := range m.types {
+		for j, b := range m.types {
+			r := m.relations[i][j]
+			if m.cell(i, j) == "" || r == mbti.RelationIdentity || (r.Symmetric() && j < i) {
+				continue
+			}
+
+			attrs := fmt.Sprintf("label=%q", r.String())
+			if r.Symmetric() {
+				attrs += ", dir=none"
+			}
+
+			fmt.Fprintf(out, "  %s -> %s [%s];\n", a, b, attrs)
+		}
+	}
+
+	_, err := fmt.Fprintln(out, "}")
+
+	return err
+}
+
+func (m *relationMatrix) json() map[string]map[string]string {
+	ret := make(map[string]map[string]string, len(m.types))
+
+	for i, a := range m.types {
+		row := make(map[string]string)
+		for j, b := range m.types {
+			if c := m.cell(i, j); c != "" {
+				row[b.String()] = c
+			}
+		}
+
+		ret[a.String()] = row
+	}
+
+	return ret
+}
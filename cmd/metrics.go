@@ -0,0 +1,50 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	httpRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mbti",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of HTTP requests by handler, method and status code.",
+	}, []string{"handler", "method", "code"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "mbti",
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of HTTP requests by handler.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"handler", "method"})
+
+	typeQueries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mbti",
+		Name:      "type_queries_total",
+		Help:      "Number of times each personality type was queried.",
+	}, []string{"type"})
+
+	quizCompletions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "mbti",
+		Name:      "quiz_completions_total",
+		Help:      "Number of completed quizzes by resulting personality type.",
+	}, []string{"type"})
+)
+
+// instrument records the request count and latency of the handler under the given name.
+func instrument(name string, h http.Handler) http.Handler {
+	labels := prometheus.Labels{"handler": name}
+
+	return promhttp.InstrumentHandlerCounter(httpRequests.MustCurryWith(labels),
+		promhttp.InstrumentHandlerDuration(httpRequestDuration.MustCurryWith(labels), h))
+}
+
+func metricsHandler() http.Handler {
+	return promhttp.Handler()
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+type candidateJSON struct {
+	Personality string   `json:"personality"`
+	Probability float64  `json:"probability"`
+	Hints       []string `json:"hints,omitempty"`
+}
+
+type mistypeJSON struct {
+	Claimed      string          `json:"claimed"`
+	Probability  float64         `json:"probability"`
+	Alternatives []candidateJSON `json:"alternatives"`
+}
+
+func runMistype(args []string) error {
+	flags := newFlagSet("mistype")
+	instantOutput := flags.Bool("instantOutput", false, "True if you want output to be shown instantly, without a typewriter-like effect")
+	answersFlag := flags.String("answers", "", "The answers to the questions as a comma separated list of 1 or 2, instead of asking them")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: expected the claimed personality type", errArguments)
+	}
+
+	claimed, err := parseInput(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	questions := quiz.MistypeQuestions(claimed)
+
+	var answers []quiz.Answer
+	if *answersFlag != "" {
+		ids := make([]string, 0, len(questions))
+		for _, q := range questions {
+			ids = append(ids, q.ID)
+		}
+
+		if answers, err = parseAnswers(ids, *answersFlag); err != nil {
+			return err
+		}
+	} else {
+		d := newTypewriter(*instantOutput, time.Second/2, time.Second/2)
+		in := bufio.NewScanner(os.Stdin)
+
+		for i, q := range questions {
+			d.Write("\n(%d/%d) ", i+1, len(questions))
+
+			choice, err := askChoice(d, in, q.Text, q.Choices[:])
+			if err != nil {
+				return err
+			}
+
+			answers = append(answers, quiz.Answer{QuestionID: q.ID, Choice: choice})
+		}
+
+		fmt.Println()
+	}
+
+	e, err := quiz.EstimateMistype(claimed, questions, answers)
+	if err != nil {
+		return err
+	}
+
+	ret := mistypeJSON{Claimed: claimed.String(), Probability: e.Probability}
+	for i, c := range e.Alternatives {
+		alt := candidateJSON{Personality: c.Personality.String(), Probability: c.Probability}
+		// Only the most likely alternative is worth explaining.
+		if i == 0 {
+			alt.Hints = mbti.Differentiate(claimed, c.Personality).Hints()
+		}
+
+		ret.Alternatives = append(ret.Alternatives, alt)
+	}
+
+	if outputFormat == formatJSON {
+		return printJSON(ret)
+	}
+
+	fmt.Printf("Probability that %s is a mistype: %.0f%%\n\nLikely alternatives:\n", claimed, e.Probability*100)
+	for _, alt := range ret.Alternatives {
+		fmt.Printf("  %s  %3.0f%%\n", alt.Personality, alt.Probability*100)
+	}
+
+	if len(ret.Alternatives) > 0 {
+		fmt.Printf("\nWhat tells %s and %s apart:\n", claimed, ret.Alternatives[0].Personality)
+		printList(ret.Alternatives[0].Hints)
+	}
+
+	return nil
+}
+
+// parseAnswers parses answers given as a comma separated list of choices,
+// in the order of the questions with the given IDs.
+func parseAnswers(ids []string, s string) ([]quiz.Answer, error) {
+	fields := strings.Split(s, ",")
+	if len(fields) != len(ids) {
+		return nil, fmt.Errorf("%w: expected %d answers, got %d", errArguments, len(ids), len(fields))
+	}
+
+	answers := make([]quiz.Answer, 0, len(fields))
+	for i, f := range fields {
+		choice, err := strconv.Atoi(strings.TrimSpace(f))
+		if err != nil || (choice != 1 && choice != 2) {
+			return nil, fmt.Errorf("%w: answer %d must be 1 or 2, got %q", errArguments, i+1, f)
+		}
+
+		answers = append(answers, quiz.Answer{QuestionID: ids[i], Choice: choice - 1})
+	}
+
+	return answers, nil
+}
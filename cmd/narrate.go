@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tmaxmax/mbti/delayed"
+)
+
+func runNarrate(args []string) error {
+	flags := newFlagSet("narrate")
+	instantOutput := flags.Bool("instantOutput", false, "True if you want output to be shown instantly, without a typewriter-like effect")
+	delay := flags.Duration("delay", 30*time.Millisecond, "The delay between letters at normal pace")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 0 {
+		return fmt.Errorf("%w: the text is read from the standard input", errArguments)
+	}
+
+	chunks := make(chan string)
+	readErr := make(chan error, 1)
+
+	go func() {
+		defer close(chunks)
+		readErr <- readChunks(os.Stdin, chunks)
+	}()
+
+	d := newTypewriter(*instantOutput, 0, 0)
+	if err := <-d.Stream(chunks, delayed.StreamOptions{GraphemeDelay: *delay}); err != nil {
+		return err
+	}
+
+	return <-readErr
+}
+
+// readChunks sends the text read from r as soon as it is available,
+// such as the tokens a language model command line tool writes as they are generated.
+func readChunks(r io.Reader, chunks chan<- string) error {
+	buf := make([]byte, 512)
+	// pending holds the bytes of a UTF-8 sequence split between reads.
+	var pending []byte
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			data := append(pending, buf[:n]...)
+			complete := validPrefix(data)
+
+			chunks <- string(data[:complete])
+			pending = append([]byte(nil), data[complete:]...)
+		}
+
+		if errors.Is(err, io.EOF) {
+			if len(pending) > 0 {
+				chunks <- string(pending)
+			}
+
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// validPrefix returns the length of data without a trailing incomplete UTF-8 sequence.
+func validPrefix(data []byte) int {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return i
+			}
+
+			break
+		}
+	}
+
+	return len(data)
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/tmaxmax/mbti/internal/api"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+type openAPISchema struct {
+	Ref        string                    `json:"$ref,omitempty"`
+	Type       string                    `json:"type,omitempty"`
+	Properties map[string]*openAPISchema `json:"properties,omitempty"`
+	Required   []string                  `json:"required,omitempty"`
+	Items      *openAPISchema            `json:"items,omitempty"`
+	// AdditionalProperties describes the values of map types.
+	AdditionalProperties *openAPISchema `json:"additionalProperties,omitempty"`
+	MinItems             *int           `json:"minItems,omitempty"`
+	MaxItems             *int           `json:"maxItems,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema"`
+}
+
+type openAPIHeader struct {
+	Description string         `json:"description,omitempty"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Headers     map[string]openAPIHeader    `json:"headers,omitempty"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIParameter struct {
+	Name        string         `json:"name"`
+	In          string         `json:"in"`
+	Description string         `json:"description,omitempty"`
+	Required    bool           `json:"required"`
+	Schema      *openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Required bool                        `json:"required"`
+	Content  map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId"`
+	Summary     string                     `json:"summary"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+	// Security overrides the security requirements of the document.
+	Security []openAPISecurityRequirement `json:"security,omitempty"`
+}
+
+// openAPISecurityRequirement maps the names of security schemes to their scopes.
+// An empty requirement makes the security optional.
+type openAPISecurityRequirement map[string][]string
+
+type openAPISecurityScheme struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name,omitempty"`
+	In          string `json:"in,omitempty"`
+	Scheme      string `json:"scheme,omitempty"`
+}
+
+type openAPIDocument struct {
+	OpenAPI string `json:"openapi"`
+	Info    struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	} `json:"info"`
+	Paths      map[string]map[string]*openAPIOperation `json:"paths"`
+	Security   []openAPISecurityRequirement            `json:"security"`
+	Components struct {
+		Schemas         map[string]*openAPISchema        `json:"schemas"`
+		SecuritySchemes map[string]openAPISecurityScheme `json:"securitySchemes"`
+	} `json:"components"`
+}
+
+// schemaGenerator derives JSON schemas from the Go types of the API's JSON forms.
+// Named struct types are added to the components and referenced.
+type schemaGenerator struct {
+	schemas map[string]*openAPISchema
+}
+
+// textMarshalerType is implemented by the types encoded as JSON strings, such as quiz.Dichotomy.
+var textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+
+func (g *schemaGenerator) schema(t reflect.Type) *openAPISchema {
+	if t.Implements(textMarshalerType) {
+		return &openAPISchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &openAPISchema{Type: "string"}
+	case reflect.Bool:
+		return &openAPISchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &openAPISchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &openAPISchema{Type: "number"}
+	case reflect.Ptr:
+		return g.schema(t.Elem())
+	case reflect.Slice:
+		return &openAPISchema{Type: "array", Items: g.schema(t.Elem())}
+	case reflect.Array:
+		n := t.Len()
+
+		return &openAPISchema{Type: "array", Items: g.schema(t.Elem()), MinItems: &n, MaxItems: &n}
+	case reflect.Map:
+		return &openAPISchema{Type: "object", AdditionalProperties: g.schema(t.Elem())}
+	case reflect.Struct:
+		if _, ok := g.schemas[t.Name()]; !ok {
+			// Register before recursing, so self-referencing types terminate.
+			g.schemas[t.Name()] = nil
+			g.schemas[t.Name()] = g.structSchema(t)
+		}
+
+		return &openAPISchema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		return &openAPISchema{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) *openAPISchema {
+	s := &openAPISchema{Type: "object", Properties: make(map[string]*openAPISchema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+
+		name, opts := f.Name, ""
+		if tag, ok := f.Tag.Lookup("json"); ok {
+			if tag == "-" {
+				continue
+			}
+
+			parts := strings.SplitN(tag, ",", 2)
+			if parts[0] != "" {
+				name = parts[0]
+			}
+
+			if len(parts) > 1 {
+				opts = parts[1]
+			}
+		}
+
+		s.Properties[name] = g.schema(f.Type)
+		if !strings.Contains(opts, "omitempty") {
+			s.Required = append(s.Required, name)
+		}
+	}
+
+	return s
+}
+
+func (g *schemaGenerator) ref(v interface{}) *openAPISchema {
+	return g.schema(reflect.TypeOf(v))
+}
+
+func jsonContent(s *openAPISchema) map[string]openAPIMediaType {
+	return map[string]openAPIMediaType{"application/json": {Schema: s}}
+}
+
+func newOpenAPIDocument() *openAPIDocument {
+	g := &schemaGenerator{schemas: make(map[string]*openAPISchema)}
+
+	errorResponse := func(description string) openAPIResponse {
+		return openAPIResponse{Description: description, Content: jsonContent(g.ref(api.Error{}))}
+	}
+
+	inputParameter := func(name, in string) openAPIParameter {
+		return openAPIParameter{
+			Name:        name,
+			In:          in,
+			Description: "A type indicator (e.g. INTJ) or a pair of dominant functions (e.g. NiTe)",
+			Required:    true,
+			Schema:      &openAPISchema{Type: "string"},
+		}
+	}
+
+	sessionParameter := openAPIParameter{
+		Name:        "id",
+		In:          "path",
+		Description: "The ID of a quiz session",
+		Required:    true,
+		Schema:      &openAPISchema{Type: "string"},
+	}
+
+	doc := &openAPIDocument{OpenAPI: "3.0.3"}
+	doc.Info.Title = "mbti"
+	doc.Info.Version = "1.0.0"
+	doc.Paths = map[string]map[string]*openAPIOperation{
+		"/types": {"get": {
+			OperationID: "listTypes",
+			Summary:     "List all 16 personality types",
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "The personality types", Content: jsonContent(g.ref([]api.Personality{}))},
+			},
+		}},
+		"/types/{indicator}": {"get": {
+			OperationID: "getType",
+			Summary:     "Get the four personalities of a mind",
+			Parameters:  []openAPIParameter{inputParameter("indicator", "path")},
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "The mind whose ego is the given personality", Content: jsonContent(g.ref(api.Mind{}))},
+				"404": errorResponse("The input is not a valid personality"),
+			},
+		}},
+		"/compare": {"get": {
+			OperationID: "compareTypes",
+			Summary:     "Compare the function stacks of two personalities",
+			Parameters:  []openAPIParameter{inputParameter("a", "query"), inputParameter("b", "query")},
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "The comparison", Content: jsonContent(g.ref(api.Comparison{}))},
+				"400": errorResponse("An input is not a valid personality"),
+			},
+		}},
+		"/quiz": {
+			"get": {
+				OperationID: "getQuestions",
+				Summary:     "Get the quiz questions",
+				Responses: map[string]openAPIResponse{
+					"200": {Description: "The questions", Content: jsonContent(g.ref([]quiz.Question{}))},
+				},
+			},
+			"post": {
+				OperationID: "scoreQuiz",
+				Summary:     "Score quiz answers",
+				RequestBody: &openAPIRequestBody{Required: true, Content: jsonContent(g.ref([]quiz.Answer{}))},
+				Responses: map[string]openAPIResponse{
+					"200": {Description: "The quiz result", Content: jsonContent(g.ref(api.QuizResult{}))},
+					"400": errorResponse("The answers are invalid"),
+				},
+			},
+		},
+		"/quiz/sessions": {"post": {
+			OperationID: "createQuizSession",
+			Summary:     "Start a quiz session, answered one question at a time",
+			Responses: map[string]openAPIResponse{
+				"201": {Description: "The session and its first question", Content: jsonContent(g.ref(api.QuizSession{}))},
+			},
+		}},
+		"/quiz/sessions/{id}": {
+			"get": {
+				OperationID: "getQuizSession",
+				Summary:     "Get the progress of a quiz session and its next question",
+				Parameters:  []openAPIParameter{sessionParameter},
+				Responses: map[string]openAPIResponse{
+					"200": {Description: "The session", Content: jsonContent(g.ref(api.QuizSession{}))},
+					"404": errorResponse("The session doesn't exist"),
+				},
+			},
+			"delete": {
+				OperationID: "deleteQuizSession",
+				Summary:     "Delete a quiz session",
+				Parameters:  []openAPIParameter{sessionParameter},
+				Responses: map[string]openAPIResponse{
+					"204": {Description: "The session is deleted"},
+					"404": errorResponse("The session doesn't exist"),
+				},
+			},
+		},
+		"/quiz/sessions/{id}/answers": {"post": {
+			OperationID: "answerQuizSession",
+			Summary:     "Answer the next question of a quiz session",
+			Parameters:  []openAPIParameter{sessionParameter},
+			RequestBody: &openAPIRequestBody{Required: true, Content: jsonContent(g.ref(quiz.Answer{}))},
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "The session and its next question", Content: jsonContent(g.ref(api.QuizSession{}))},
+				"400": errorResponse("The answer is invalid or not for the next question"),
+				"404": errorResponse("The session doesn't exist"),
+				"409": errorResponse("All questions are already answered"),
+			},
+		}},
+		"/quiz/sessions/{id}/result": {"get": {
+			OperationID: "getQuizSessionResult",
+			Summary:     "Get the result of a completed quiz session",
+			Parameters:  []openAPIParameter{sessionParameter},
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "The quiz result", Content: jsonContent(g.ref(api.QuizResult{}))},
+				"404": errorResponse("The session doesn't exist"),
+				"409": errorResponse("Not all questions are answered"),
+			},
+		}},
+		"/healthz": {"get": {
+			OperationID: "healthz",
+			Summary:     "Check whether the server is alive",
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "The server is alive", Content: jsonContent(g.ref(healthJSON{}))},
+			},
+		}},
+		"/readyz": {"get": {
+			OperationID: "readyz",
+			Summary:     "Check whether the server is ready to receive requests",
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "The server is ready", Content: jsonContent(g.ref(healthJSON{}))},
+				"503": {Description: "A readiness check failed or the server is shutting down", Content: jsonContent(g.ref(healthJSON{}))},
+			},
+		}},
+		"/stream": {"get": {
+			OperationID: "streamType",
+			Summary:     "Stream the description of a mind with a typewriter effect",
+			Parameters:  []openAPIParameter{inputParameter("type", "query")},
+			Responses: map[string]openAPIResponse{
+				"200": {
+					Description: "Server-sent events, each containing a JSON string chunk of the description, followed by a \"done\" event",
+					Content:     map[string]openAPIMediaType{"text/event-stream": {Schema: &openAPISchema{Type: "string"}}},
+				},
+				"400": errorResponse("The input is not a valid personality"),
+			},
+		}},
+	}
+	doc.Components.Schemas = g.schemas
+
+	// Requests are authenticated and rate limited only if the server is
+	// configured so, which the document can't tell.
+	const keyDescription = "One of the API keys of the server, required if it has any"
+
+	doc.Components.SecuritySchemes = map[string]openAPISecurityScheme{
+		"apiKey":     {Type: "apiKey", Description: keyDescription, Name: "X-API-Key", In: "header"},
+		"bearerAuth": {Type: "http", Description: keyDescription, Scheme: "bearer"},
+	}
+	doc.Security = []openAPISecurityRequirement{{"apiKey": {}}, {"bearerAuth": {}}, {}}
+
+	for path, operations := range doc.Paths {
+		for _, op := range operations {
+			if publicPaths[path] {
+				op.Security = []openAPISecurityRequirement{{}}
+			} else {
+				op.Responses["401"] = errorResponse("The API key is missing or invalid")
+			}
+
+			if !unlimitedPaths[path] {
+				op.Responses["429"] = openAPIResponse{
+					Description: "The client exceeded the rate limit",
+					Headers: map[string]openAPIHeader{
+						"Retry-After": {Description: "The number of seconds to wait before retrying", Schema: &openAPISchema{Type: "integer"}},
+					},
+					Content: jsonContent(g.ref(api.Error{})),
+				}
+			}
+		}
+	}
+
+	return doc
+}
+
+func handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newOpenAPIDocument())
+}
+
+func printOpenAPI() error {
+	data, err := json.MarshalIndent(newOpenAPIDocument(), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(append(data, '\n'))
+
+	return err
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// formatFunctions writes the functions separated by spaces, in the alphabet of
+// the locale if one is registered for it, as in "Ii De Fi Ee" for German.
+func formatFunctions(functions []mbti.Function) string {
+	a, ok := mbti.LookupAlphabet(inputLocale)
+	if !ok {
+		return mbti.JoinFunctions(functions, " ")
+	}
+
+	representations := make([]string, 0, len(functions))
+	for _, fn := range functions {
+		representations = append(representations, a.Format(fn))
+	}
+
+	return strings.Join(representations, " ")
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/delayed"
+	"github.com/tmaxmax/mbti/internal/api"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+const defaultResultsFile = "results.json"
+
+func runQuiz(args []string) error {
+	flags := newFlagSet("quiz")
+	instantOutput := flags.Bool("instantOutput", false, "True if you want output to be shown instantly, without a typewriter-like effect")
+	save := flags.String("save", "", "Append the result to the given JSON file")
+	questionsPath := flags.String("questions", "", "Ask the questions of the given YAML or JSON question bank instead of the built-in ones")
+	compare := flags.Bool("compare", false, "Compare the result with the last one saved in the file given by -save without asking")
+	track := flags.Bool("track", false, "Record the result in the tracking file shown by the track command")
+	answersFlag := flags.String("answers", "", "The answers to the questions as a comma separated list of 1 or 2, instead of asking them")
+	history := flags.Bool("history", false, "List the results saved in the file given by -save (default \""+defaultResultsFile+"\") and exit")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	path := *save
+	if path == "" {
+		path = defaultResultsFile
+	}
+
+	if *history {
+		return printQuizHistory(os.Stdout, path)
+	}
+
+	questions := quiz.DefaultQuestions
+	if *questionsPath != "" {
+		q, err := loadQuestionBank(*questionsPath)
+		if err != nil {
+			return err
+		}
+
+		questions = q
+	}
+
+	d := newTypewriter(*instantOutput, time.Second/2, time.Second/2)
+
+	in := bufio.NewScanner(os.Stdin)
+
+	var result *quiz.Result
+	if *answersFlag != "" {
+		ids := make([]string, 0, len(questions))
+		for _, q := range questions {
+			ids = append(ids, q.ID)
+		}
+
+		answers, err := parseAnswers(ids, *answersFlag)
+		if err != nil {
+			return err
+		}
+
+		if result, err = quiz.Evaluate(questions, answers); err != nil {
+			return err
+		}
+	} else {
+		r, err := askQuestions(d, in, questions)
+		if err != nil {
+			return err
+		}
+
+		result = r
+	}
+
+	ego, err := result.Personality()
+	if err != nil {
+		return err
+	}
+
+	ego = ego.WithModel(model)
+
+	if outputFormat == formatJSON {
+		if err := printJSON(api.QuizResult{Indicator: result.Indicator(), Scores: result.Scores, Mind: api.NewMind(mbti.NewMind(ego))}); err != nil {
+			return err
+		}
+	} else {
+		<-queueQuizResult(d, result).Wait().Do()
+		<-queueMind(d, ego).Do()
+	}
+
+	// The results file is only read if the results are saved or compared.
+	var records []quizRecord
+	if *save != "" || *compare {
+		if records, err = loadQuizRecords(path); err != nil {
+			return err
+		}
+	}
+
+	// Comparisons are only shown as text, so they don't mix with JSON output.
+	if len(records) > 0 && outputFormat != formatJSON {
+		prev := records[len(records)-1]
+
+		if *compare || (interactiveOutput && confirm(d, in, "Compare with your previous result from %s (%s)?", prev.Time.Local().Format("2006-01-02"), prev.Indicator)) {
+			if err := printRetakeComparison(os.Stdout, prev, result); err != nil {
+				return err
+			}
+		}
+	}
+
+	if *track {
+		if err := trackQuizResult(result); err != nil {
+			return err
+		}
+	}
+
+	if *save != "" {
+		if err := saveQuizRecord(*save, quizRecord{Time: time.Now(), Indicator: result.Indicator(), Scores: result.Scores}); err != nil {
+			return err
+		}
+
+		if outputFormat != formatJSON {
+			fmt.Printf("Result saved to %s.\n", *save)
+		}
+	}
+
+	return nil
+}
+
+// askQuestions asks each question and evaluates the answers.
+func askQuestions(d *delayed.Delayed, in *bufio.Scanner, questions []quiz.Question) (*quiz.Result, error) {
+	answers := make([]quiz.Answer, 0, len(questions))
+
+	for i, q := range questions {
+		if accessible {
+			d.Write("\nQuestion %d of %d: %s...\n", i+1, len(questions), q.Text).
+				Write("Choice 1: %s.\nChoice 2: %s.\nAnswer 1 or 2.\n", q.Choices[0], q.Choices[1])
+		} else {
+			d.Write("\n(%d/%d) %s...\n", i+1, len(questions), q.Text).
+				Write("  1) %s\n  2) %s\n", q.Choices[0], q.Choices[1])
+		}
+
+		<-prompt(d, "-> ").Do()
+
+		for {
+			if !in.Scan() {
+				if err := in.Err(); err != nil {
+					return nil, fmt.Errorf("input error: %w", err)
+				}
+
+				return nil, fmt.Errorf("input error: %w", io.ErrUnexpectedEOF)
+			}
+
+			choice := strings.TrimSpace(in.Text())
+			if choice == "1" || choice == "2" {
+				answers = append(answers, quiz.Answer{QuestionID: q.ID, Choice: int(choice[0] - '1')})
+
+				break
+			}
+
+			<-prompt(d.Write("Please answer 1 or 2.\n"), "-> ").Do()
+		}
+	}
+
+	return quiz.Evaluate(questions, answers)
+}
+
+func formatScore(s quiz.Score) string {
+	first, second, _ := s.Dichotomy.Poles()
+
+	return fmt.Sprintf("%c %d - %d %c", first, s.First, s.Second, second)
+}
+
+func queueQuizResult(d *delayed.Delayed, r *quiz.Result) *delayed.Delayed {
+	d.Write("\nYour type is %s.\n", r.Indicator(), time.Second).Wait()
+
+	for _, s := range r.Scores {
+		d.Write("  %s (%.0f%% %c)\n", formatScore(s), s.Strength()*100, s.Preference(), time.Second/2)
+	}
+
+	return d.Write("\n")
+}
+
+var errInvalidQuestions = errors.New("invalid question bank")
+
+// loadQuestionBank loads and validates a question bank. Coverage problems
+// that don't prevent scoring are reported as warnings.
+func loadQuestionBank(path string) ([]quiz.Question, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	questions, err := quiz.LoadQuestions(f)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %v", errInvalidQuestions, path, err)
+	}
+
+	var fatal []string
+
+	for _, p := range quiz.Validate(questions) {
+		if p.Fatal {
+			fatal = append(fatal, p.String())
+		} else {
+			fmt.Fprintf(os.Stderr, "Warning: %s: %s\n", path, p)
+		}
+	}
+
+	if len(fatal) > 0 {
+		return nil, fmt.Errorf("%w %s: %s", errInvalidQuestions, path, strings.Join(fatal, "; "))
+	}
+
+	return questions, nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti/internal/atomicfile"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// quizRecord is a saved quiz result.
+type quizRecord struct {
+	Time      time.Time     `json:"time"`
+	Indicator string        `json:"indicator"`
+	Scores    [4]quiz.Score `json:"scores"`
+}
+
+func loadQuizRecords(path string) ([]quizRecord, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var records []quizRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("invalid results file %s: %w", path, err)
+	}
+
+	return records, nil
+}
+
+func saveQuizRecord(path string, r quizRecord) error {
+	records, err := loadQuizRecords(path)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(append(records, r), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// formatDrift describes how the scores changed from one attempt to the next.
+func formatDrift(prev, curr quizRecord) string {
+	var changes []string
+
+	for i, s := range curr.Scores {
+		p := prev.Scores[i]
+		if s.Preference() != p.Preference() {
+			changes = append(changes, fmt.Sprintf("%c→%c", p.Preference(), s.Preference()))
+		}
+
+		// Balances range from -1 to 1, so halving their difference gives
+		// the shift in the share of answers favoring a pole.
+		first, second, _ := s.Dichotomy.Poles()
+		if diff := (s.Balance() - p.Balance()) / 2; diff >= 0.005 {
+			changes = append(changes, fmt.Sprintf("%c%+.0f%%", first, diff*100))
+		} else if diff <= -0.005 {
+			changes = append(changes, fmt.Sprintf("%c%+.0f%%", second, -diff*100))
+		}
+	}
+
+	if len(changes) == 0 {
+		return "no change"
+	}
+
+	return strings.Join(changes, ", ")
+}
+
+func printQuizHistory(w io.Writer, path string) error {
+	records, err := loadQuizRecords(path)
+	if err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		_, err := fmt.Fprintf(w, "No results saved in %s.\n", path)
+
+		return err
+	}
+
+	for i, r := range records {
+		scores := make([]string, 0, len(r.Scores))
+		for _, s := range r.Scores {
+			scores = append(scores, formatScore(s))
+		}
+
+		fmt.Fprintf(w, "%s  %s  [%s]\n", r.Time.Local().Format("2006-01-02 15:04"), r.Indicator, strings.Join(scores, ", "))
+
+		if i > 0 {
+			fmt.Fprintf(w, "                  drift: %s\n", formatDrift(records[i-1], r))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+)
+
+var (
+	errNoMatchingType     = errors.New("no personality type meets the constraints")
+	errUnknownTemperament = errors.New("unknown temperament")
+)
+
+func runRandom(args []string) error {
+	flags := newFlagSet("random")
+	introvert := flags.Bool("introvert", false, "Only pick introverted types")
+	extravert := flags.Bool("extravert", false, "Only pick extraverted types")
+	temperament := flags.String("temperament", "", "Only pick types of the given temperament: NT, NF, SJ or SP")
+	seed := flags.Int64("seed", 0, "The seed of the random generator. The current time is used if 0")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("%w: random takes no arguments", errArguments)
+	}
+
+	wantTemperament := mbti.Temperament(strings.ToUpper(*temperament))
+	if *temperament != "" && !isTemperament(wantTemperament) {
+		return &usageError{err: fmt.Errorf("%w %q", errUnknownTemperament, *temperament), flags: flags}
+	}
+
+	var predicates []mbti.Predicate
+
+	if *introvert {
+		predicates = append(predicates, mbti.WithLetter('I'))
+	}
+
+	if *extravert {
+		predicates = append(predicates, mbti.WithLetter('E'))
+	}
+
+	if *temperament != "" {
+		predicates = append(predicates, mbti.ByTemperament(wantTemperament))
+	}
+
+	candidates := mbti.Filter(predicates...)
+
+	if len(candidates) == 0 {
+		return errNoMatchingType
+	}
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	p := candidates[rand.New(rand.NewSource(*seed)).Intn(len(candidates))]
+
+	if outputFormat == formatJSON {
+		return printJSON(newRandomJSON(p))
+	}
+
+	fmt.Println(p)
+
+	return nil
+}
+
+type randomJSON struct {
+	Personality string   `json:"personality"`
+	Temperament string   `json:"temperament"`
+	Functions   []string `json:"functions"`
+}
+
+func newRandomJSON(p *mbti.Personality) randomJSON {
+	functions := make([]string, 0, 4)
+	for _, fn := range p.Functions() {
+		functions = append(functions, fn.String())
+	}
+
+	return randomJSON{Personality: p.String(), Temperament: string(p.Temperament()), Functions: functions}
+}
+
+func isTemperament(t mbti.Temperament) bool {
+	for _, known := range mbti.Temperaments {
+		if t == known {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,224 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenBucket is a token bucket refilled continuously at a fixed rate.
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+	rate   float64
+	burst  float64
+}
+
+// rateLimiter limits requests per client using one token bucket for each key.
+// Clients with an API key that has its own limit are limited by it instead of
+// the default one. It runs before authentication, so requests with an invalid
+// key are limited as well, which makes guessing keys impractical.
+type rateLimiter struct {
+	rate  float64
+	burst float64
+	key   func(*http.Request) string
+	keys  []apiKeyConfig
+
+	buckets map[string]*tokenBucket
+	mu      sync.Mutex
+}
+
+func newRateLimiter(rate float64, burst int, key func(*http.Request) string, keys []apiKeyConfig) *rateLimiter {
+	return &rateLimiter{
+		rate:    rate,
+		burst:   float64(max(burst, 1)),
+		key:     key,
+		keys:    keys,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// unauthenticatedRate is the number of requests per second allowed for each
+// client without a valid API key when keys are required and no default limit
+// is set. These requests are rejected, so this only slows down key guessing.
+const unauthenticatedRate = 1
+
+// limit returns the bucket key and the limit of the request. A rate of 0 means the request isn't limited.
+func (l *rateLimiter) limit(r *http.Request) (key string, rate, burst float64) {
+	k := findAPIKey(l.keys, apiKey(r))
+	if k != nil && k.RateLimit > 0 {
+		burst := l.burst
+		if k.RateBurst > 0 {
+			burst = float64(k.RateBurst)
+		}
+
+		// Distinct from the buckets of rateLimitKey, which share the default limit.
+		return "limit:" + k.Name, k.RateLimit, burst
+	}
+
+	if k == nil && len(l.keys) > 0 && l.rate <= 0 {
+		return l.key(r), unauthenticatedRate, l.burst
+	}
+
+	return l.key(r), l.rate, l.burst
+}
+
+// take consumes a token from the bucket of the given key. If none is
+// available it returns false and how long to wait until one is.
+func (l *rateLimiter) take(key string, rate, burst float64, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.rate, b.burst = rate, burst
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+
+		return true, 0
+	}
+
+	return false, time.Duration((1 - b.tokens) / rate * float64(time.Second))
+}
+
+// evict removes the buckets that have been refilled completely, as they
+// are indistinguishable from new ones.
+func (l *rateLimiter) evict(now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, b := range l.buckets {
+		if b.tokens+now.Sub(b.last).Seconds()*b.rate >= b.burst {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// run evicts full buckets periodically until done is closed.
+func (l *rateLimiter) run(done <-chan struct{}) {
+	interval := time.Minute
+	if l.rate > 0 {
+		interval = time.Duration(l.burst / l.rate * float64(time.Second))
+	}
+
+	if interval < time.Second {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			l.evict(now)
+		}
+	}
+}
+
+var errRateLimited = errors.New("rate limit exceeded")
+
+// unlimitedPaths are the operational endpoints probed by infrastructure,
+// which are never rate limited.
+var unlimitedPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+func (l *rateLimiter) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key, rate, burst := l.limit(r)
+		if unlimitedPaths[r.URL.Path] || rate <= 0 {
+			next.ServeHTTP(w, r)
+
+			return
+		}
+
+		ok, wait := l.take(key, rate, burst, time.Now())
+		if !ok {
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			writeError(w, http.StatusTooManyRequests, errRateLimited)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// apiKey returns the API key sent in the X-API-Key header or as a bearer token.
+func apiKey(r *http.Request) string {
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		return key
+	}
+
+	const prefix = "Bearer "
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, prefix) {
+		return strings.TrimPrefix(auth, prefix)
+	}
+
+	return ""
+}
+
+// clientIP returns the IP address of the client. If trustProxy is true,
+// the rightmost address of the X-Forwarded-For header is preferred: it is
+// the one appended by the proxy, while the ones before it are sent by the
+// client and can't be trusted.
+func clientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			addrs := strings.Split(values[len(values)-1], ",")
+			if addr := strings.TrimSpace(addrs[len(addrs)-1]); addr != "" {
+				return addr
+			}
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+var errUnknownRateLimitKey = errors.New("rate limit key must be either \"ip\" or \"api-key\"")
+
+// rateLimitKey returns the function that extracts the rate limiting key of a request.
+// When keying by API key, only the configured keys are used, by name; requests
+// without a valid key are limited by IP, so made up keys can't evade the limit.
+func rateLimitKey(kind string, trustProxy bool, keys []apiKeyConfig) (func(*http.Request) string, error) {
+	byIP := func(r *http.Request) string {
+		return "ip:" + clientIP(r, trustProxy)
+	}
+
+	switch kind {
+	case "ip":
+		return byIP, nil
+	case "api-key":
+		return func(r *http.Request) string {
+			if k := findAPIKey(keys, apiKey(r)); k != nil {
+				return "key:" + k.Name
+			}
+
+			return byIP(r)
+		}, nil
+	default:
+		return nil, errUnknownRateLimitKey
+	}
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/internal/api"
+)
+
+type relationTemplateData struct {
+	A           *mbti.Personality
+	B           *mbti.Personality
+	Relation    mbti.RelationType
+	Description mbti.RelationDescription
+	Notes       []string
+	// Compatibility scores the relation and how the functions of the two interact.
+	Compatibility *mbti.CompatibilityReport
+}
+
+func runRelate(args []string) error {
+	flags := newFlagSet("relate")
+	templatePath := addTemplateFlag(flags, "the relation (.A, .B, .Relation, .Description, .Notes, .Compatibility)")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	tmpl, err := loadTemplate(*templatePath)
+	if err != nil {
+		return err
+	}
+
+	if flags.NArg() != 2 {
+		return fmt.Errorf("%w: expected two personalities to relate", errArguments)
+	}
+
+	a, err := parseInput(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	b, err := parseInput(flags.Arg(1))
+	if err != nil {
+		return err
+	}
+
+	recordHistory("relate", flags.Arg(0)+" "+flags.Arg(1))
+
+	r := mbti.Relationship(a, b)
+	desc := r.Description()
+	notes := relationNotes(a, b)
+	c := mbti.Compatibility(a, b)
+
+	switch {
+	case tmpl != nil:
+		return executeTemplate(tmpl, relationTemplateData{A: a, B: b, Relation: r, Description: desc, Notes: notes, Compatibility: c})
+	case outputFormat == formatJSON:
+		return printJSON(relateJSON{
+			A:             api.NewPersonality(a),
+			B:             api.NewPersonality(b),
+			Relation:      r.String(),
+			Inverse:       r.Inverse().String(),
+			Dynamics:      desc.Dynamics,
+			Strengths:     desc.Strengths,
+			Friction:      desc.Friction,
+			Notes:         notes,
+			Compatibility: c.Score,
+			RelationScore: c.RelationScore,
+			FunctionScore: c.StackScore,
+		})
+	}
+
+	if r.Symmetric() {
+		fmt.Printf("%s and %s: %s\n\n", a, b, r)
+	} else {
+		fmt.Printf("%s is the %s of %s (%s of %s)\n\n", a, r, b, r.Inverse(), a)
+	}
+
+	fmt.Printf("%s\n\nStrengths:\n", desc.Dynamics)
+	printList(desc.Strengths)
+	fmt.Printf("\nFriction points:\n")
+	printList(desc.Friction)
+
+	if len(notes) > 0 {
+		fmt.Printf("\nNotes:\n")
+		printList(notes)
+	}
+
+	fmt.Printf("\nCompatibility: %.0f%% (relation %.0f%%, functions %.0f%%)\n", c.Score*100, c.RelationScore*100, c.StackScore*100)
+	for _, in := range c.Interactions {
+		fmt.Printf("  %s's %-9s %s is %s's %s\n", b, mbti.PositionNames[in.Position], in.Function, a, in.Role)
+	}
+
+	return nil
+}
+
+type relateJSON struct {
+	A api.Personality `json:"a"`
+	B api.Personality `json:"b"`
+	// Relation is the relation of A towards B, and Inverse the one of B towards A.
+	Relation      string   `json:"relation"`
+	Inverse       string   `json:"inverse"`
+	Dynamics      string   `json:"dynamics"`
+	Strengths     []string `json:"strengths"`
+	Friction      []string `json:"friction"`
+	Notes         []string `json:"notes,omitempty"`
+	Compatibility float64  `json:"compatibility"`
+	RelationScore float64  `json:"relationScore"`
+	FunctionScore float64  `json:"functionScore"`
+}
+
+// relationNotes returns the notes the loaded metadata has about the relation, from both sides.
+func relationNotes(a, b *mbti.Personality) []string {
+	var notes []string
+
+	if n, ok := a.Metadata().RelationNotes[b.Indicator()]; ok {
+		notes = append(notes, n)
+	}
+
+	if a.Indicator() != b.Indicator() {
+		if n, ok := b.Metadata().RelationNotes[a.Indicator()]; ok {
+			notes = append(notes, n)
+		}
+	}
+
+	return notes
+}
+
+func printList(items []string) {
+	for _, item := range items {
+		fmt.Printf("  - %s\n", item)
+	}
+}
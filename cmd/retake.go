@@ -0,0 +1,73 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/delayed"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// confirm asks a yes or no question, defaulting to no.
+func confirm(d *delayed.Delayed, in *bufio.Scanner, format string, args ...interface{}) bool {
+	<-prompt(d, format+" [y/N] ", args...).Do()
+
+	if !in.Scan() {
+		return false
+	}
+
+	answer := strings.ToLower(strings.TrimSpace(in.Text()))
+
+	return answer == "y" || answer == "yes"
+}
+
+// printRetakeComparison shows how the result changed since a previous attempt
+// and, if a flipped preference was borderline, what tells the two types apart.
+func printRetakeComparison(w io.Writer, prev quizRecord, curr *quiz.Result) error {
+	changes := quiz.Diff(&quiz.Result{Scores: prev.Scores}, curr)
+
+	fmt.Fprintf(w, "\nCompared with your result from %s (%s):\n", prev.Time.Local().Format("2006-01-02 15:04"), prev.Indicator)
+
+	var borderline []string
+
+	for _, c := range changes {
+		before, after := c.Before, c.After
+
+		flipped := ""
+		if c.Flipped() {
+			flipped = " (flipped)"
+		}
+
+		fmt.Fprintf(w, "  %-12s %c → %c%s, strength %.0f%% → %.0f%% (%+.0f%%)\n", before.Dichotomy.String()+":",
+			before.Preference(), after.Preference(), flipped, before.Strength()*100, after.Strength()*100, c.StrengthDelta()*100)
+
+		if c.LikelyMistype() {
+			borderline = append(borderline, before.Dichotomy.String())
+		}
+	}
+
+	if len(borderline) == 0 {
+		return nil
+	}
+
+	a, err := mbti.FromIndicator(prev.Indicator)
+	if err != nil {
+		return err
+	}
+
+	b, err := curr.Personality()
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nLikely mistype between %s and %s: the %s preference is borderline.\n", a, b, strings.Join(borderline, " and "))
+
+	for _, hint := range mbti.Differentiate(a, b).Hints() {
+		fmt.Fprintf(w, "  - %s\n", hint)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+var errNoQuery = errors.New("no search query given")
+
+type searchJSON struct {
+	Personality string   `json:"personality"`
+	Nickname    string   `json:"nickname,omitempty"`
+	Group       string   `json:"group,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Score       int      `json:"score"`
+	Matches     []string `json:"matches"`
+}
+
+func runSearch(args []string) error {
+	flags := newFlagSet("search")
+	limit := flags.Int("limit", 5, "The maximum number of results shown. All results are shown if 0")
+
+	if err := parseInterspersedFlags(flags, args); err != nil {
+		return err
+	}
+
+	query := strings.Join(flags.Args(), " ")
+	if query == "" {
+		return errNoQuery
+	}
+
+	recordHistory("search", query)
+
+	results := mbti.Search(query)
+	if *limit > 0 && len(results) > *limit {
+		results = results[:*limit]
+	}
+
+	if outputFormat == formatJSON {
+		ret := make([]searchJSON, 0, len(results))
+		for _, r := range results {
+			ret = append(ret, searchJSON{Personality: r.Personality.String(), Nickname: r.Nickname, Group: r.Group, Description: r.Description, Score: r.Score, Matches: r.Matches})
+		}
+
+		return printJSON(ret)
+	}
+
+	if len(results) == 0 {
+		fmt.Printf("No personality types match %q.\n", query)
+
+		return nil
+	}
+
+	for _, r := range results {
+		fmt.Printf("%s  %-12s %-10s (%s)\n      %s\n", r.Personality, r.Nickname, r.Group, strings.Join(r.Matches, ", "), r.Description)
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/internal/api"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+func runServe(args []string) error {
+	flags := newFlagSet("serve")
+	addr := flags.String("addr", ":8080", "The address the HTTP server listens on")
+	unixSocket := flags.String("unix", "", "Listen on the Unix socket at the given path instead of the TCP address, for local clients")
+	printSpec := flags.Bool("print-openapi", false, "Print the OpenAPI document of the server and exit")
+	rateLimit := flags.Float64("rate-limit", 0, "The number of requests per second allowed for each client. Requests aren't limited if 0")
+	rateBurst := flags.Int("rate-burst", 10, "The number of requests a client can make at once before being rate limited")
+	rateKey := flags.String("rate-key", "ip", "Identify clients for rate limiting by \"ip\" or \"api-key\"")
+	trustProxy := flags.Bool("trust-proxy", false, "Use the X-Forwarded-For header to determine the client IP")
+	cacheDir := flags.String("cache-dir", "", "A directory where rendered responses are cached in addition to memory")
+	cacheSize := flags.Int("cache-size", 1024, "The maximum number of rendered responses kept in memory. Unlimited if 0")
+	cacheMaxAge := flags.Duration("cache-max-age", 24*time.Hour, "How long clients may cache responses, sent in the Cache-Control header")
+	webhookURL := flags.String("webhook", "", "A URL notified with a POST request when a quiz is completed, besides the webhooks in the configuration file")
+	webhookSecret := flags.String("webhook-secret", "", "The secret signing the requests to the -webhook URL. Defaults to the MBTI_WEBHOOK_SECRET environment variable")
+	sessionStore := flags.String("sessions", "memory", "Where quiz sessions are stored: \"memory\" or \"file:<directory>\"")
+	shutdownTimeout := flags.Duration("shutdown-timeout", 30*time.Second, "How long to wait for in-flight requests and streams to finish on shutdown")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	// Servers log their lifecycle and requests by default.
+	configureLogging(slog.LevelInfo)
+
+	if *printSpec {
+		return printOpenAPI()
+	}
+
+	cache, err := newResponseCache(*cacheDir, *cacheMaxAge, *cacheSize)
+	if err != nil {
+		return err
+	}
+
+	sessions, err := newSessionStore(*sessionStore)
+	if err != nil {
+		return err
+	}
+
+	keys := apiKeys()
+
+	s := &apiServer{
+		questions: quiz.DefaultQuestions,
+		cache:     cache,
+		sessions:  sessions,
+		webhooks:  newWebhooks(*webhookURL, *webhookSecret),
+		web:       len(keys) == 0,
+	}
+	handler := s.handler()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if len(keys) > 0 {
+		slog.Info("API key authentication enabled, web UI disabled", "keys", len(keys))
+		handler = authenticate(keys, handler)
+	}
+
+	// The limiter runs before authentication, so failed attempts are limited too.
+	if *rateLimit > 0 || len(keys) > 0 {
+		key, err := rateLimitKey(*rateKey, *trustProxy, keys)
+		if err != nil {
+			return err
+		}
+
+		limiter := newRateLimiter(*rateLimit, *rateBurst, key, keys)
+		go limiter.run(ctx.Done())
+
+		handler = limiter.middleware(handler)
+	}
+
+	srv := &http.Server{Addr: *addr, Handler: logRequests(handler)}
+
+	lis, err := listen(*addr, *unixSocket)
+	if err != nil {
+		return err
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		slog.Info("listening", "addr", lis.Addr())
+		errChan <- srv.Serve(lis)
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+	}
+
+	slog.Info("shutting down, waiting for in-flight requests", "timeout", *shutdownTimeout)
+	s.drain()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), *shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+
+	return s.webhooks.wait(shutdownCtx)
+}
+
+// apiServer holds the state shared by the HTTP handlers.
+type apiServer struct {
+	questions []quiz.Question
+	cache     *responseCache
+	sessions  quiz.SessionStore
+	webhooks  *webhooks
+	// web is true if the web UI is served.
+	web bool
+	// sessionLocks serializes the requests for each quiz session.
+	sessionLocks keyedMutex
+	// draining is set to 1 when the server is shutting down.
+	draining int32
+}
+
+func (s *apiServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", handleHealthz)
+	mux.HandleFunc("/readyz", s.handleReadyz)
+	mux.Handle("/types", instrument("types", http.HandlerFunc(s.handleTypes)))
+	mux.Handle("/types/", instrument("type", http.HandlerFunc(s.handleType)))
+	mux.Handle("/compare", instrument("compare", http.HandlerFunc(s.handleCompare)))
+	mux.Handle("/quiz", instrument("quiz", http.HandlerFunc(s.handleQuiz)))
+	mux.Handle("/quiz/sessions", instrument("sessions", http.HandlerFunc(s.handleSessions)))
+	mux.Handle("/quiz/sessions/", instrument("session", http.HandlerFunc(s.handleSession)))
+	mux.Handle("/stream", instrument("stream", http.HandlerFunc(handleStream)))
+	mux.Handle("/openapi.json", http.HandlerFunc(handleOpenAPI))
+	mux.Handle("/metrics", metricsHandler())
+
+	if s.web {
+		mux.Handle("/", instrument("web", webHandler()))
+	}
+
+	return mux
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("failed to write response", "err", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, api.Error{Error: err.Error()})
+}
+
+// maxRequestBody is the maximum size of a request body, in bytes. The largest
+// bodies the server expects are the answers to a whole quiz.
+const maxRequestBody = 1 << 20
+
+// readJSON decodes the JSON body of the request into v, writing an error
+// response and returning false if it can't. Bodies over maxRequestBody are rejected.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBody)).Decode(v)
+	if err == nil {
+		return true
+	}
+
+	status := http.StatusBadRequest
+
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		status = http.StatusRequestEntityTooLarge
+	}
+
+	writeError(w, status, err)
+
+	return false
+}
+
+var errMethodNotAllowed = errors.New("method not allowed")
+
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeError(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+
+	return false
+}
+
+func (s *apiServer) handleTypes(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	s.cache.write(w, r, cacheKey("types", nil, ""), func() (interface{}, error) {
+		all := mbti.All()
+		ret := make([]api.Personality, 0, len(all))
+
+		for _, p := range all {
+			ret = append(ret, api.NewPersonality(p.WithModel(model)))
+		}
+
+		return ret, nil
+	})
+}
+
+func (s *apiServer) handleType(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	p, err := parseInput(strings.TrimPrefix(r.URL.Path, "/types/"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+
+		return
+	}
+
+	typeQueries.WithLabelValues(p.String()).Inc()
+
+	s.cache.write(w, r, cacheKey("type", []string{p.String()}, ""), func() (interface{}, error) {
+		return api.NewMind(mbti.NewMind(p)), nil
+	})
+}
+
+func (s *apiServer) handleCompare(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	query := r.URL.Query()
+
+	a, err := parseInput(query.Get("a"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	b, err := parseInput(query.Get("b"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	typeQueries.WithLabelValues(a.String()).Inc()
+	typeQueries.WithLabelValues(b.String()).Inc()
+
+	s.cache.write(w, r, cacheKey("compare", []string{a.String(), b.String()}, ""), func() (interface{}, error) {
+		return api.NewComparison(mbti.Compare(a, b)), nil
+	})
+}
+
+func (s *apiServer) handleQuiz(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet, http.MethodPost) {
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		writeJSON(w, http.StatusOK, s.questions)
+
+		return
+	}
+
+	var answers []quiz.Answer
+	if !readJSON(w, r, &answers) {
+		return
+	}
+
+	result, err := quiz.Evaluate(s.questions, answers)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	ret, err := api.NewQuizResult(result)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	quizCompletions.WithLabelValues(ret.Indicator).Inc()
+	s.webhooks.quizCompleted(result, nil)
+
+	writeJSON(w, http.StatusOK, ret)
+}
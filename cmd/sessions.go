@@ -0,0 +1,204 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/tmaxmax/mbti/internal/api"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+var errUnknownSessionStore = errors.New("session store must be either \"memory\" or \"file:<directory>\"")
+
+// newSessionStore creates the session store selected with the -sessions flag.
+func newSessionStore(spec string) (quiz.SessionStore, error) {
+	switch {
+	case spec == "memory":
+		return quiz.NewMemoryStore(), nil
+	case strings.HasPrefix(spec, "file:") && len(spec) > len("file:"):
+		return quiz.NewFileStore(strings.TrimPrefix(spec, "file:"))
+	default:
+		return nil, fmt.Errorf("%w, got %q", errUnknownSessionStore, spec)
+	}
+}
+
+// keyedMutex serializes operations by key, such as the requests for the same
+// quiz session, so that an answer isn't lost between loading and saving the
+// session. The locks of keys that aren't in use are removed. The zero value is ready to use.
+type keyedMutex struct {
+	locks map[string]*keyedLock
+	mu    sync.Mutex
+}
+
+type keyedLock struct {
+	sync.Mutex
+	// refs is the number of goroutines holding or waiting for the lock.
+	refs int
+}
+
+// lock locks the given key and returns the function that unlocks it.
+func (k *keyedMutex) lock(key string) (unlock func()) {
+	k.mu.Lock()
+	if k.locks == nil {
+		k.locks = make(map[string]*keyedLock)
+	}
+
+	l, ok := k.locks[key]
+	if !ok {
+		l = &keyedLock{}
+		k.locks[key] = l
+	}
+
+	l.refs++
+	k.mu.Unlock()
+
+	l.Lock()
+
+	return func() {
+		l.Unlock()
+
+		k.mu.Lock()
+		defer k.mu.Unlock()
+
+		if l.refs--; l.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
+
+// sessionStatus returns the HTTP status for errors of session operations.
+func sessionStatus(err error) int {
+	switch {
+	case errors.Is(err, quiz.ErrSessionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, quiz.ErrSessionComplete), errors.Is(err, quiz.ErrSessionIncomplete):
+		return http.StatusConflict
+	case errors.Is(err, quiz.ErrUnexpectedAnswer), errors.Is(err, quiz.ErrInvalidChoice):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// handleSessions creates quiz sessions.
+func (s *apiServer) handleSessions(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodPost) {
+		return
+	}
+
+	session, err := quiz.NewSession()
+	if err == nil {
+		err = s.sessions.Save(r.Context(), session)
+	}
+
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	w.Header().Set("Location", "/quiz/sessions/"+session.ID)
+	writeJSON(w, http.StatusCreated, api.NewQuizSession(session, s.questions))
+}
+
+// handleSession serves /quiz/sessions/{id}, /quiz/sessions/{id}/answers and /quiz/sessions/{id}/result.
+func (s *apiServer) handleSession(w http.ResponseWriter, r *http.Request) {
+	id, action := strings.TrimPrefix(r.URL.Path, "/quiz/sessions/"), ""
+	if i := strings.IndexByte(id, '/'); i >= 0 {
+		id, action = id[:i], id[i+1:]
+	}
+
+	switch action {
+	case "":
+		if !allowMethods(w, r, http.MethodGet, http.MethodDelete) {
+			return
+		}
+	case "answers":
+		if !allowMethods(w, r, http.MethodPost) {
+			return
+		}
+	case "result":
+		if !allowMethods(w, r, http.MethodGet) {
+			return
+		}
+	default:
+		http.NotFound(w, r)
+
+		return
+	}
+
+	// Requests for the same session are handled one at a time. Servers
+	// sharing a file store across processes aren't coordinated.
+	defer s.sessionLocks.lock(id)()
+
+	session, err := s.sessions.Load(r.Context(), id)
+	if err != nil {
+		writeError(w, sessionStatus(err), err)
+
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodDelete:
+		if err := s.sessions.Delete(r.Context(), id); err != nil {
+			writeError(w, sessionStatus(err), err)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	case action == "answers":
+		s.answerSession(w, r, session)
+	case action == "result":
+		s.sessionResult(w, session)
+	default:
+		writeJSON(w, http.StatusOK, api.NewQuizSession(session, s.questions))
+	}
+}
+
+func (s *apiServer) answerSession(w http.ResponseWriter, r *http.Request, session *quiz.Session) {
+	var answer quiz.Answer
+	if !readJSON(w, r, &answer) {
+		return
+	}
+
+	if err := session.Answer(s.questions, answer); err != nil {
+		writeError(w, sessionStatus(err), err)
+
+		return
+	}
+
+	if err := s.sessions.Save(r.Context(), session); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if result, err := session.Result(s.questions); err == nil {
+		quizCompletions.WithLabelValues(result.Indicator()).Inc()
+		s.webhooks.quizCompleted(result, session)
+	}
+
+	writeJSON(w, http.StatusOK, api.NewQuizSession(session, s.questions))
+}
+
+func (s *apiServer) sessionResult(w http.ResponseWriter, session *quiz.Session) {
+	result, err := session.Result(s.questions)
+	if err != nil {
+		writeError(w, sessionStatus(err), err)
+
+		return
+	}
+
+	ret, err := api.NewQuizResult(result)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ret)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// slowStore returns the loaded sessions late, so concurrent requests
+// load the same session before any of them saves it.
+type slowStore struct {
+	*quiz.MemoryStore
+}
+
+func (s slowStore) Load(ctx context.Context, id string) (*quiz.Session, error) {
+	session, err := s.MemoryStore.Load(ctx, id)
+	time.Sleep(10 * time.Millisecond)
+
+	return session, err
+}
+
+func TestAnswerSessionConcurrently(t *testing.T) {
+	store := slowStore{quiz.NewMemoryStore()}
+	s := &apiServer{questions: quiz.DefaultQuestions, sessions: store}
+	handler := s.handler()
+
+	session, err := quiz.NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Save(context.Background(), session); err != nil {
+		t.Fatal(err)
+	}
+
+	const requests = 5
+
+	var (
+		wg       sync.WaitGroup
+		statuses = make(chan int, requests)
+		body     = `{"questionId": "` + quiz.DefaultQuestions[0].ID + `", "choice": 0}`
+	)
+
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			r := httptest.NewRequest(http.MethodPost, "/quiz/sessions/"+session.ID+"/answers", strings.NewReader(body))
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			statuses <- w.Code
+		}()
+	}
+
+	wg.Wait()
+	close(statuses)
+
+	ok := 0
+	for status := range statuses {
+		if status == http.StatusOK {
+			ok++
+		}
+	}
+
+	if ok != 1 {
+		t.Fatalf("%d answers to the same question succeeded, want 1", ok)
+	}
+
+	saved, err := store.Load(context.Background(), session.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(saved.Answers) != 1 {
+		t.Fatalf("got %d saved answers, want 1", len(saved.Answers))
+	}
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// maxBarWidth is the width of the longest bar of the charts.
+const maxBarWidth = 30
+
+type shareJSON struct {
+	Count      int     `json:"count"`
+	Fraction   float64 `json:"fraction"`
+	Population float64 `json:"population"`
+	Deviation  float64 `json:"deviation"`
+}
+
+type distributionJSON struct {
+	Total        int                  `json:"total"`
+	Types        map[string]shareJSON `json:"types"`
+	Letters      map[string]shareJSON `json:"letters"`
+	Temperaments map[string]shareJSON `json:"temperaments"`
+	Errors       []rosterError        `json:"errors,omitempty"`
+}
+
+func newShareJSON(s mbti.Share) shareJSON {
+	return shareJSON{Count: s.Count, Fraction: s.Fraction, Population: s.Baseline, Deviation: s.Deviation()}
+}
+
+func runStats(args []string) error {
+	flags := newFlagSet("stats")
+	typeCol := flags.String("type-col", "", "The CSV column holding the types, as a number starting from 1 or a header name. Defaults to the last column")
+	chart := flags.Bool("chart", false, "Draw a bar chart of the distributions")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: expected a CSV file", errArguments)
+	}
+
+	path := flags.Arg(0)
+
+	members, errs, err := readTeamCSV(path, "", *typeCol)
+	if err != nil {
+		return err
+	}
+
+	personalities := make([]*mbti.Personality, 0, len(members))
+	for _, m := range members {
+		personalities = append(personalities, m.Personality)
+	}
+
+	d := mbti.Distribute(personalities)
+
+	if outputFormat == formatJSON {
+		ret := distributionJSON{
+			Total:        d.Total,
+			Types:        make(map[string]shareJSON, len(d.Types)),
+			Letters:      make(map[string]shareJSON, len(d.Letters)),
+			Temperaments: make(map[string]shareJSON, len(d.Temperaments)),
+			Errors:       errs,
+		}
+
+		for indicator, s := range d.Types {
+			ret.Types[indicator] = newShareJSON(s)
+		}
+
+		for letter, s := range d.Letters {
+			ret.Letters[string(letter)] = newShareJSON(s)
+		}
+
+		for temperament, s := range d.Temperaments {
+			ret.Temperaments[string(temperament)] = newShareJSON(s)
+		}
+
+		return printJSON(ret)
+	}
+
+	for _, e := range errs {
+		fmt.Fprintf(os.Stderr, "%s:%s\n", path, e)
+	}
+
+	return printDistribution(os.Stdout, d, *chart && !accessible)
+}
+
+func printDistribution(out io.Writer, d *mbti.Distribution, chart bool) error {
+	fmt.Fprintf(out, "%d personalities\n\n", d.Total)
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	var (
+		types        = make([]string, 0, len(d.Types))
+		typeShares   = make([]mbti.Share, 0, len(d.Types))
+		letters      = make([]string, 0, len(mbti.Letters))
+		letterShares = make([]mbti.Share, 0, len(mbti.Letters))
+		temperaments = make([]string, 0, len(mbti.Temperaments))
+		tempShares   = make([]mbti.Share, 0, len(mbti.Temperaments))
+	)
+
+	for indicator := range d.Types {
+		types = append(types, indicator)
+	}
+
+	sort.Strings(types)
+
+	for _, indicator := range types {
+		typeShares = append(typeShares, d.Types[indicator])
+	}
+
+	for _, l := range mbti.Letters {
+		letters = append(letters, string(l))
+		letterShares = append(letterShares, d.Letters[l])
+	}
+
+	for _, t := range mbti.Temperaments {
+		temperaments = append(temperaments, string(t))
+		tempShares = append(tempShares, d.Temperaments[t])
+	}
+
+	printShares(w, "TYPE", types, typeShares, chart)
+	fmt.Fprintln(w)
+	printShares(w, "LETTER", letters, letterShares, chart)
+	fmt.Fprintln(w)
+	printShares(w, "TEMPERAMENT", temperaments, tempShares, chart)
+
+	return w.Flush()
+}
+
+func printShares(w io.Writer, title string, names []string, shares []mbti.Share, chart bool) {
+	fmt.Fprintf(w, "%s\tCOUNT\tSHARE\tPOPULATION\tDEVIATION", title)
+	if chart {
+		fmt.Fprintf(w, "\tCHART")
+	}
+	fmt.Fprintln(w)
+
+	var max float64
+	for _, s := range shares {
+		max = math.Max(max, math.Max(s.Fraction, s.Baseline))
+	}
+
+	for i, s := range shares {
+		fmt.Fprintf(w, "%s\t%d\t%.1f%%\t%.1f%%\t%+.1f%%", names[i], s.Count, s.Fraction*100, s.Baseline*100, s.Deviation()*100)
+		if chart {
+			fmt.Fprintf(w, "\t%s", bar(s, max))
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// bar draws the share of the dataset, marking the population baseline with a '|'.
+func bar(s mbti.Share, max float64) string {
+	if max == 0 {
+		return ""
+	}
+
+	width := int(math.Round(s.Fraction / max * maxBarWidth))
+	baseline := int(math.Round(s.Baseline / max * maxBarWidth))
+
+	ret := []byte(strings.Repeat("#", width) + strings.Repeat(" ", maxBarWidth-width))
+	if baseline > 0 {
+		ret[baseline-1] = '|'
+	}
+
+	return strings.TrimRight(string(ret), " ")
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/tmaxmax/mbti"
+)
+
+type teamMemberJSON struct {
+	Name        string `json:"name"`
+	Personality string `json:"personality"`
+	Temperament string `json:"temperament"`
+}
+
+type functionCoverageJSON struct {
+	Function  string `json:"function"`
+	Dominant  int    `json:"dominant"`
+	Auxiliary int    `json:"auxiliary"`
+}
+
+type pairDynamicJSON struct {
+	A        string `json:"a"`
+	B        string `json:"b"`
+	Relation string `json:"relation"`
+}
+
+type teamAnalysisJSON struct {
+	Members      []teamMemberJSON       `json:"members"`
+	Temperaments map[string]int         `json:"temperaments"`
+	Coverage     []functionCoverageJSON `json:"coverage"`
+	Gaps         []string               `json:"gaps"`
+	Pairs        []pairDynamicJSON      `json:"pairs"`
+	Errors       []rosterError          `json:"errors,omitempty"`
+}
+
+func runTeam(args []string) error {
+	flags := newFlagSet("team")
+	csvPath := flags.String("csv", "", "Read the team from a CSV file, in addition to the types given as arguments")
+	nameCol := flags.String("name-col", "1", "The CSV column holding the names, as a number starting from 1 or a header name")
+	typeCol := flags.String("type-col", "", "The CSV column holding the types, as a number starting from 1 or a header name. Defaults to the last column")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	var (
+		members []mbti.TeamMember
+		errs    []rosterError
+	)
+
+	if *csvPath != "" {
+		m, e, err := readTeamCSV(*csvPath, *nameCol, *typeCol)
+		if err != nil {
+			return err
+		}
+
+		members, errs = m, e
+	}
+
+	for _, input := range flags.Args() {
+		p, err := parseInput(input)
+		if err != nil {
+			return err
+		}
+
+		members = append(members, mbti.TeamMember{Name: input, Personality: p})
+	}
+
+	if len(members) < 2 {
+		return fmt.Errorf("%w: expected at least two team members", errArguments)
+	}
+
+	t := mbti.AnalyzeTeam(members)
+
+	if outputFormat == formatJSON {
+		ret := newTeamAnalysisJSON(t)
+		ret.Errors = errs
+
+		return printJSON(ret)
+	}
+
+	for _, e := range errs {
+		fmt.Fprintf(os.Stderr, "%s:%s\n", *csvPath, e)
+	}
+
+	return printTeamAnalysis(os.Stdout, t)
+}
+
+func printTeamAnalysis(out io.Writer, t *mbti.TeamAnalysis) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(w, "NAME\tTYPE\tTEMPERAMENT\tFUNCTIONS\n")
+	for _, m := range t.Members {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", m.Name, m.Personality, m.Personality.Temperament(), formatFunctions(m.Personality.Functions()))
+	}
+
+	fmt.Fprintf(w, "\nTEMPERAMENT\tMEMBERS\n")
+	for _, temperament := range mbti.Temperaments {
+		fmt.Fprintf(w, "%s\t%d\n", temperament, t.Temperaments[temperament])
+	}
+
+	fmt.Fprintf(w, "\nFUNCTION\tDOMINANT\tAUXILIARY\n")
+	for _, c := range t.Coverage {
+		fmt.Fprintf(w, "%s\t%d\t%d\n", c.Function, c.Dominant, c.Auxiliary)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "\nCoverage gaps: %s\n", formatFunctionsOrNone(t.Gaps))
+
+	if len(t.Pairs) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(out, "\nNotable pairs:\n")
+	for _, p := range t.Pairs {
+		if p.Relation.Symmetric() {
+			fmt.Fprintf(out, "  %s and %s: %s\n", formatMember(p.A), formatMember(p.B), p.Relation)
+		} else {
+			fmt.Fprintf(out, "  %s is the %s of %s\n", formatMember(p.A), strings.ToLower(p.Relation.String()), formatMember(p.B))
+		}
+	}
+
+	return nil
+}
+
+func formatMember(m mbti.TeamMember) string {
+	if m.Name == m.Personality.String() {
+		return m.Name
+	}
+
+	return fmt.Sprintf("%s (%s)", m.Name, m.Personality)
+}
+
+func newTeamAnalysisJSON(t *mbti.TeamAnalysis) teamAnalysisJSON {
+	ret := teamAnalysisJSON{
+		Temperaments: make(map[string]int, len(t.Temperaments)),
+		Gaps:         make([]string, 0, len(t.Gaps)),
+		Pairs:        make([]pairDynamicJSON, 0, len(t.Pairs)),
+	}
+
+	for _, m := range t.Members {
+		ret.Members = append(ret.Members, teamMemberJSON{
+			Name:        m.Name,
+			Personality: m.Personality.String(),
+			Temperament: string(m.Personality.Temperament()),
+		})
+	}
+
+	for temperament, count := range t.Temperaments {
+		ret.Temperaments[string(temperament)] = count
+	}
+
+	for _, c := range t.Coverage {
+		ret.Coverage = append(ret.Coverage, functionCoverageJSON{Function: c.Function.String(), Dominant: c.Dominant, Auxiliary: c.Auxiliary})
+	}
+
+	for _, fn := range t.Gaps {
+		ret.Gaps = append(ret.Gaps, fn.String())
+	}
+
+	for _, p := range t.Pairs {
+		ret.Pairs = append(ret.Pairs, pairDynamicJSON{A: p.A.Name, B: p.B.Name, Relation: p.Relation.String()})
+	}
+
+	return ret
+}
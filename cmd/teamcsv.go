@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+var errUnknownColumn = errors.New("unknown column")
+
+// rosterError is a row of a team CSV file that couldn't be read.
+type rosterError struct {
+	Line    int    `json:"line"`
+	Name    string `json:"name,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e rosterError) String() string {
+	if e.Name == "" {
+		return fmt.Sprintf("%d: %s", e.Line, e.Message)
+	}
+
+	return fmt.Sprintf("%d: %s: %s", e.Line, e.Name, e.Message)
+}
+
+// columnIndex resolves a column given by its number, starting from 1, or by
+// its name in the header row. The last column is selected by -1.
+func columnIndex(column string, header []string) (int, error) {
+	if column == "" {
+		return -1, nil
+	}
+
+	if n, err := strconv.Atoi(column); err == nil {
+		if n < 1 {
+			return 0, fmt.Errorf("%w %q: column numbers start from 1", errUnknownColumn, column)
+		}
+
+		return n - 1, nil
+	}
+
+	for i, name := range header {
+		if strings.EqualFold(strings.TrimSpace(name), column) {
+			return i, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w %q", errUnknownColumn, column)
+}
+
+func isColumnNumber(column string) bool {
+	_, err := strconv.Atoi(column)
+
+	return column == "" || err == nil
+}
+
+// readTeamCSV reads the team members from a CSV file. Rows whose type can't
+// be parsed are reported and skipped, so the rest of the team can still be
+// analyzed. The first row is taken as a header if columns are selected by
+// name or if it doesn't hold a valid type.
+func readTeamCSV(path, nameColumn, typeColumn string) ([]mbti.TeamMember, []rosterError, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, nil, nil
+	}
+
+	header := records[0]
+
+	nameIndex, err := columnIndex(nameColumn, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	typeIndex, err := columnIndex(typeColumn, header)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasHeader := !isColumnNumber(nameColumn) || !isColumnNumber(typeColumn)
+	if !hasHeader {
+		if input, ok := field(header, typeIndex); ok {
+			_, err := parseInput(input)
+			hasHeader = err != nil
+		}
+	}
+
+	var (
+		members []mbti.TeamMember
+		errs    []rosterError
+	)
+
+	for i, record := range records {
+		if i == 0 && hasHeader {
+			continue
+		}
+
+		line := i + 1
+		name, _ := field(record, nameIndex)
+
+		input, ok := field(record, typeIndex)
+		if !ok {
+			errs = append(errs, rosterError{Line: line, Name: name, Message: "missing type column"})
+
+			continue
+		}
+
+		p, err := parseInput(input)
+		if err != nil {
+			errs = append(errs, rosterError{Line: line, Name: name, Message: err.Error()})
+
+			continue
+		}
+
+		if name == "" {
+			name = p.String()
+		}
+
+		members = append(members, mbti.TeamMember{Name: name, Personality: p})
+	}
+
+	return members, errs, nil
+}
+
+// field returns the field of the record at the given index, or the last one if the index is -1.
+func field(record []string, index int) (string, bool) {
+	if index < 0 {
+		index = len(record) - 1
+	}
+
+	if index < 0 || index >= len(record) {
+		return "", false
+	}
+
+	return strings.TrimSpace(record[index]), true
+}
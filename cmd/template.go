@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+var errInvalidTemplate = errors.New("invalid template")
+
+// templateFuncs are the functions available to user templates, in addition to the built-in ones.
+var templateFuncs = template.FuncMap{
+	"functions": formatFunctions,
+	"join":      strings.Join,
+	"lower":     strings.ToLower,
+	"upper":     strings.ToUpper,
+}
+
+// addTemplateFlag adds the flag selecting a template file that replaces the default output.
+// The data available to the template is described by the flag's usage.
+func addTemplateFlag(flags *flag.FlagSet, data string) *string {
+	return flags.String("template", "", "Render the output with the text/template in the given file, executed with "+data)
+}
+
+// loadTemplate parses the template file, or returns nil if no file is given.
+func loadTemplate(path string) (*template.Template, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := template.New(filepath.Base(path)).Funcs(templateFuncs).Parse(string(text))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidTemplate, err)
+	}
+
+	return t, nil
+}
+
+func executeTemplate(t *template.Template, data interface{}) error {
+	if err := t.Execute(os.Stdout, data); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidTemplate, err)
+	}
+
+	return nil
+}
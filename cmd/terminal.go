@@ -0,0 +1,101 @@
+package main
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti/delayed"
+	"golang.org/x/term"
+)
+
+// isTerminal reports whether the file is a terminal. Other character
+// devices, such as /dev/null, and pipes and regular files are not.
+func isTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// interactiveOutput is true if the output is shown in a terminal. Otherwise
+// the output is piped or redirected, so the typewriter effect and prompts
+// are disabled, which makes the output instant and deterministic.
+var interactiveOutput = isTerminal(os.Stdout)
+
+// newTypewriter creates the Delayed utility used for output to the terminal.
+// Delays are ignored if instant is true, in accessible mode or if the output is not a terminal.
+func newTypewriter(instant bool, printDuration, waitDuration time.Duration) *delayed.Delayed {
+	return delayed.New(delayed.Properties{
+		// The standard output is replaced while the output is copied, so it
+		// is read on each call instead of relying on the package default.
+		Writer:        os.Stdout,
+		IgnoreDelays:  instant || accessible || !interactiveOutput,
+		PrintDuration: printDuration,
+		WaitDuration:  waitDuration,
+	})
+}
+
+// prompt queues the given prompt text, if prompts are enabled.
+func prompt(d *delayed.Delayed, format string, args ...interface{}) *delayed.Delayed {
+	if !interactiveOutput {
+		return d
+	}
+
+	return d.Write(format, append(args, time.Duration(0))...)
+}
+
+// Color support levels, as reported by colorSupport.
+const (
+	colorNone      = "none"
+	colorBasic     = "16 colors"
+	color256       = "256 colors"
+	colorTrueColor = "true color"
+)
+
+// colorSupport detects the colors the terminal supports from the environment,
+// following the NO_COLOR convention. There is no color if the output is not a terminal.
+func colorSupport() string {
+	term := os.Getenv("TERM")
+
+	switch {
+	case !interactiveOutput, accessible, os.Getenv("NO_COLOR") != "", term == "dumb":
+		return colorNone
+	case os.Getenv("COLORTERM") == "truecolor", os.Getenv("COLORTERM") == "24bit":
+		return colorTrueColor
+	case strings.Contains(term, "256color"):
+		return color256
+	default:
+		return colorBasic
+	}
+}
+
+// cursorControl reports whether the terminal understands cursor movement sequences.
+func cursorControl() bool {
+	return interactiveOutput && !accessible && os.Getenv("TERM") != "dumb"
+}
+
+// terminalWidth returns the width of the terminal, falling back to the
+// COLUMNS environment variable if the terminal can't be queried.
+func terminalWidth() (width int, source string) {
+	if interactiveOutput {
+		if w, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && w > 0 {
+			return w, "terminal"
+		}
+	}
+
+	if w, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && w > 0 {
+		return w, "COLUMNS"
+	}
+
+	return 0, "unknown"
+}
+
+// locale returns the locale selected by the environment, in POSIX precedence order.
+func locale() string {
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if l := os.Getenv(name); l != "" {
+			return l
+		}
+	}
+
+	return "C"
+}
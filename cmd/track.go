@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/tmaxmax/mbti/pkg/tracking"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// trackingPath returns the path of the tracking file, which can be set
+// using the MBTI_TRACKING environment variable.
+func trackingPath() (string, error) {
+	if path := os.Getenv("MBTI_TRACKING"); path != "" {
+		return path, nil
+	}
+
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "mbti", "tracking.json"), nil
+}
+
+func trackingStore() (*tracking.FileStore, error) {
+	path, err := trackingPath()
+	if err != nil {
+		return nil, err
+	}
+
+	return tracking.NewFileStore(path), nil
+}
+
+// trackQuizResult records a quiz result in the tracking file.
+func trackQuizResult(r *quiz.Result) error {
+	store, err := trackingStore()
+	if err != nil {
+		return err
+	}
+
+	scores := r.Scores
+
+	return store.Append(tracking.Entry{Time: time.Now().UTC(), Source: tracking.SourceQuiz, Indicator: r.Indicator(), Scores: &scores})
+}
+
+type trackJSON struct {
+	Entries         []tracking.Entry `json:"entries"`
+	Modal           string           `json:"modal,omitempty"`
+	Stability       float64          `json:"stability"`
+	LetterStability [4]float64       `json:"letterStability"`
+	Changes         int              `json:"changes"`
+	Drift           [4]float64       `json:"drift"`
+}
+
+func runTrack(args []string) error {
+	flags := newFlagSet("track")
+	add := flags.String("add", "", "Record the given type as self-reported")
+	note := flags.String("note", "", "A note stored with the type given by -add")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() > 0 {
+		return fmt.Errorf("%w: track takes no arguments", errArguments)
+	}
+
+	store, err := trackingStore()
+	if err != nil {
+		return err
+	}
+
+	if *add != "" {
+		p, err := parseInput(*add)
+		if err != nil {
+			return err
+		}
+
+		if err := store.Append(tracking.Entry{Time: time.Now().UTC(), Source: tracking.SourceSelfReport, Indicator: p.Indicator(), Note: *note}); err != nil {
+			return err
+		}
+	}
+
+	entries, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	m := tracking.Analyze(entries)
+
+	if outputFormat == formatJSON {
+		if entries == nil {
+			entries = []tracking.Entry{}
+		}
+
+		return printJSON(trackJSON{
+			Entries:         entries,
+			Modal:           m.Modal,
+			Stability:       m.Stability,
+			LetterStability: m.LetterStability,
+			Changes:         m.Changes,
+			Drift:           m.Drift,
+		})
+	}
+
+	if len(entries) == 0 {
+		fmt.Println("No types recorded yet. Use -add or take the quiz with -track.")
+
+		return nil
+	}
+
+	for _, e := range entries {
+		fmt.Printf("%s  %-11s %s", e.Time.Local().Format("2006-01-02 15:04"), e.Source, e.Indicator)
+		if e.Note != "" {
+			fmt.Printf("  %s", e.Note)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("\nMost frequent type: %s (%.0f%% of %d entries, %d changes)\n", m.Modal, m.Stability*100, m.Entries, m.Changes)
+
+	letters := make([]string, 0, len(m.LetterStability))
+	for i, s := range m.LetterStability {
+		letters = append(letters, fmt.Sprintf("%c %.0f%%", m.Modal[i], s*100))
+	}
+
+	fmt.Printf("Letter stability: %s\n", strings.Join(letters, ", "))
+
+	var drift []string
+	for i, d := range m.Drift {
+		// Balances range from -1 to 1, so halving their difference gives the
+		// shift in the share of answers favoring a pole, as in the quiz history.
+		first, second, _ := quiz.Dichotomies[i].Poles()
+		if d/2 >= 0.005 {
+			drift = append(drift, fmt.Sprintf("%c%+.0f%%", first, d/2*100))
+		} else if d/2 <= -0.005 {
+			drift = append(drift, fmt.Sprintf("%c%+.0f%%", second, -d/2*100))
+		}
+	}
+
+	if len(drift) > 0 {
+		fmt.Printf("Quiz drift: %s\n", strings.Join(drift, ", "))
+	}
+
+	return nil
+}
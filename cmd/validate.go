@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+var errValidationFailed = errors.New("validation failed")
+
+// maxSuggestions is the number of suggestions shown for an invalid input.
+const maxSuggestions = 3
+
+type diagnostic struct {
+	Line        int      `json:"line"`
+	Input       string   `json:"input"`
+	Message     string   `json:"message"`
+	Suggestions []string `json:"suggestions"`
+}
+
+func runValidate(args []string) error {
+	flags := newFlagSet("validate")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return fmt.Errorf("%w: expected a file to validate, or - for the standard input", errArguments)
+	}
+
+	name := flags.Arg(0)
+
+	var in io.Reader = os.Stdin
+	if name != "-" {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		in = f
+	}
+
+	diagnostics, err := validate(in)
+	if err != nil {
+		return err
+	}
+
+	if outputFormat == formatJSON {
+		if diagnostics == nil {
+			diagnostics = []diagnostic{}
+		}
+
+		if err := printJSON(diagnostics); err != nil {
+			return err
+		}
+	} else {
+		for _, d := range diagnostics {
+			fmt.Printf("%s:%d: %s\n", name, d.Line, d.Message)
+
+			if len(d.Suggestions) > 0 {
+				fmt.Printf("%s:%d: did you mean %s?\n", name, d.Line, strings.Join(d.Suggestions, " or "))
+			}
+		}
+	}
+
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("%w: %d invalid inputs in %s", errValidationFailed, len(diagnostics), name)
+	}
+
+	return nil
+}
+
+// validate checks every input on every line, skipping blank lines and comments starting with #.
+func validate(in io.Reader) ([]diagnostic, error) {
+	var diagnostics []diagnostic
+
+	s := bufio.NewScanner(in)
+
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		for _, input := range mbti.Fields(text) {
+			if _, err := parseInput(input); err != nil {
+				suggestions := mbti.Suggest(input)
+				if len(suggestions) > maxSuggestions {
+					suggestions = suggestions[:maxSuggestions]
+				}
+
+				diagnostics = append(diagnostics, diagnostic{Line: line, Input: input, Message: err.Error(), Suggestions: suggestions})
+			}
+		}
+	}
+
+	return diagnostics, s.Err()
+}
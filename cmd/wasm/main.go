@@ -0,0 +1,121 @@
+//go:build js && wasm
+// +build js,wasm
+
+/*
+Command wasm exposes the mbti library to JavaScript. Build it with
+
+	GOOS=js GOARCH=wasm go build -o mbti.wasm ./cmd/wasm
+
+and load it using the wasm_exec.js support file shipped with Go. Once
+running, it registers a global "mbti" object with the following functions:
+
+	mbti.parse(input)        // {indicator, functions}
+	mbti.mind(input)         // {ego, unconscious, subconscious, superEgo}
+	mbti.compare(a, b)       // {a, b, sharedFunctions, samePosition}
+	mbti.questions()         // [{id, text, dichotomy, choices}]
+	mbti.scoreQuiz(answers)  // {indicator, scores, mind}
+
+Inputs are type indicators (INTJ) or dominant function pairs (NiTe). On
+failure the functions return an object of the form {error: "message"}.
+*/
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"syscall/js"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/internal/api"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+var errArguments = errors.New("wrong number of arguments")
+
+// toJS converts v to a JavaScript value by round-tripping it through JSON.
+func toJS(v interface{}, err error) interface{} {
+	if err != nil {
+		v = api.Error{Error: err.Error()}
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return toJS(nil, err)
+	}
+
+	return js.Global().Get("JSON").Call("parse", string(data))
+}
+
+func wrap(argc int, fn func(args []js.Value) (interface{}, error)) js.Func {
+	return js.FuncOf(func(_ js.Value, args []js.Value) interface{} {
+		if len(args) != argc {
+			return toJS(nil, errArguments)
+		}
+
+		return toJS(fn(args))
+	})
+}
+
+func parse(args []js.Value) (interface{}, error) {
+	p, err := mbti.Parse(args[0].String())
+	if err != nil {
+		return nil, err
+	}
+
+	return api.NewPersonality(p), nil
+}
+
+func mind(args []js.Value) (interface{}, error) {
+	p, err := mbti.Parse(args[0].String())
+	if err != nil {
+		return nil, err
+	}
+
+	return api.NewMind(mbti.NewMind(p)), nil
+}
+
+func compare(args []js.Value) (interface{}, error) {
+	a, err := mbti.Parse(args[0].String())
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := mbti.Parse(args[1].String())
+	if err != nil {
+		return nil, err
+	}
+
+	return api.NewComparison(mbti.Compare(a, b)), nil
+}
+
+func questions([]js.Value) (interface{}, error) {
+	return quiz.DefaultQuestions, nil
+}
+
+func scoreQuiz(args []js.Value) (interface{}, error) {
+	data := js.Global().Get("JSON").Call("stringify", args[0]).String()
+
+	var answers []quiz.Answer
+	if err := json.Unmarshal([]byte(data), &answers); err != nil {
+		return nil, err
+	}
+
+	result, err := quiz.Evaluate(quiz.DefaultQuestions, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.NewQuizResult(result)
+}
+
+func main() {
+	js.Global().Set("mbti", js.ValueOf(map[string]interface{}{
+		"parse":     wrap(1, parse),
+		"mind":      wrap(1, mind),
+		"compare":   wrap(2, compare),
+		"questions": wrap(0, questions),
+		"scoreQuiz": wrap(1, scoreQuiz),
+	}))
+
+	select {}
+}
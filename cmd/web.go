@@ -0,0 +1,108 @@
+package main
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"time"
+
+	"github.com/tmaxmax/mbti/delayed"
+)
+
+//go:embed web
+var webFiles embed.FS
+
+func webHandler() http.Handler {
+	root, _ := fs.Sub(webFiles, "web")
+
+	return http.FileServer(http.FS(root))
+}
+
+var errStreamingUnsupported = errors.New("streaming unsupported")
+
+// sseWriter sends each written string as a server-sent event.
+type sseWriter struct {
+	w http.ResponseWriter
+	f http.Flusher
+}
+
+func newSSEWriter(w http.ResponseWriter) (*sseWriter, error) {
+	f, ok := w.(http.Flusher)
+	if !ok {
+		return nil, errStreamingUnsupported
+	}
+
+	h := w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+
+	return &sseWriter{w: w, f: f}, nil
+}
+
+func (s *sseWriter) event(name, data string) error {
+	if name != "" {
+		if _, err := fmt.Fprintf(s.w, "event: %s\n", name); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintf(s.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+
+	s.f.Flush()
+
+	return nil
+}
+
+// WriteString sends the text JSON-encoded, so newlines survive the event framing.
+func (s *sseWriter) WriteString(text string) (int, error) {
+	data, _ := json.Marshal(text)
+	if err := s.event("", string(data)); err != nil {
+		return 0, err
+	}
+
+	return len(text), nil
+}
+
+func (s *sseWriter) Write(p []byte) (int, error) {
+	return s.WriteString(string(p))
+}
+
+// streamExecutor paces the streams of all connections.
+var streamExecutor = delayed.NewExecutor(delayed.Properties{
+	PrintDuration: time.Second,
+	WaitDuration:  time.Second / 2,
+})
+
+func handleStream(w http.ResponseWriter, r *http.Request) {
+	if !allowMethods(w, r, http.MethodGet) {
+		return
+	}
+
+	ego, err := parseInput(r.URL.Query().Get("type"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+
+		return
+	}
+
+	typeQueries.WithLabelValues(ego.String()).Inc()
+
+	sse, err := newSSEWriter(w)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if err := queueMind(streamExecutor.New(sse), ego).Run(r.Context()); err != nil {
+		return
+	}
+
+	_ = sse.event("done", "{}")
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// webhookConfig is an endpoint notified when a quiz is completed. If the secret
+// is set, the body is signed with HMAC-SHA256 and the signature is sent in the
+// X-MBTI-Signature header as "sha256=<hex>".
+type webhookConfig struct {
+	URL    string `json:"url"`
+	Secret string `json:"secret,omitempty"`
+}
+
+const (
+	eventQuizCompleted = "quiz.completed"
+	// webhookAttempts is the number of times a delivery is tried, doubling the delay between attempts.
+	webhookAttempts = 3
+)
+
+// webhookEvent is the body posted to the webhooks.
+type webhookEvent struct {
+	Event     string        `json:"event"`
+	Indicator string        `json:"indicator"`
+	Scores    [4]quiz.Score `json:"scores"`
+	// Strengths are the strengths of the preferences, keyed by their letter.
+	Strengths map[string]float64 `json:"strengths"`
+	SessionID string             `json:"sessionId,omitempty"`
+	// StartedAt is known for quizzes taken in a session.
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	CompletedAt time.Time  `json:"completedAt"`
+}
+
+// webhooks delivers events to the configured webhooks in the background.
+type webhooks struct {
+	hooks  []webhookConfig
+	client *http.Client
+	retry  time.Duration
+
+	wg sync.WaitGroup
+}
+
+// newWebhooks returns the webhooks of the configuration file together with the one
+// given by flags, or nil if there are none. The secret of the latter defaults to the
+// MBTI_WEBHOOK_SECRET environment variable.
+func newWebhooks(url, secret string) *webhooks {
+	hooks := append([]webhookConfig(nil), settings.Webhooks...)
+	if url != "" {
+		if secret == "" {
+			secret = os.Getenv("MBTI_WEBHOOK_SECRET")
+		}
+
+		hooks = append(hooks, webhookConfig{URL: url, Secret: secret})
+	}
+
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	return &webhooks{hooks: hooks, client: &http.Client{Timeout: 10 * time.Second}, retry: time.Second}
+}
+
+// quizCompleted notifies the webhooks of a completed quiz.
+func (w *webhooks) quizCompleted(r *quiz.Result, session *quiz.Session) {
+	if w == nil {
+		return
+	}
+
+	ev := webhookEvent{
+		Event:       eventQuizCompleted,
+		Indicator:   r.Indicator(),
+		Scores:      r.Scores,
+		Strengths:   make(map[string]float64, len(r.Scores)),
+		CompletedAt: time.Now().UTC(),
+	}
+
+	for _, s := range r.Scores {
+		ev.Strengths[string(s.Preference())] = s.Strength()
+	}
+
+	if session != nil {
+		ev.SessionID = session.ID
+		ev.StartedAt = &session.Created
+	}
+
+	body, err := json.Marshal(ev)
+	if err != nil {
+		slog.Error("failed to encode webhook event", "err", err)
+
+		return
+	}
+
+	for _, hook := range w.hooks {
+		w.wg.Add(1)
+		go func(hook webhookConfig) {
+			defer w.wg.Done()
+			w.deliver(hook, ev.Event, body)
+		}(hook)
+	}
+}
+
+func (w *webhooks) deliver(hook webhookConfig, event string, body []byte) {
+	delay := w.retry
+
+	for attempt := 1; ; attempt++ {
+		err := w.post(hook, event, body)
+		if err == nil {
+			slog.Info("delivered webhook", "url", hook.URL, "event", event, "attempt", attempt)
+
+			return
+		}
+
+		if attempt == webhookAttempts {
+			slog.Error("failed to deliver webhook", "url", hook.URL, "event", event, "err", err)
+
+			return
+		}
+
+		slog.Warn("webhook delivery failed, retrying", "url", hook.URL, "event", event, "err", err, "in", delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (w *webhooks) post(hook webhookConfig, event string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "mbti-webhook")
+	req.Header.Set("X-MBTI-Event", event)
+
+	if hook.Secret != "" {
+		req.Header.Set("X-MBTI-Signature", "sha256="+sign(hook.Secret, body))
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %s", res.Status)
+	}
+
+	return nil
+}
+
+// sign returns the hex encoded HMAC-SHA256 of the body.
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// wait waits for the pending deliveries to finish or for the context to be done.
+func (w *webhooks) wait(ctx context.Context) error {
+	if w == nil {
+		return nil
+	}
+
+	done := make(chan struct{})
+	go func() {
+		w.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,34 @@
+package mbti
+
+// Comparison lists the functions two personalities have in common.
+type Comparison struct {
+	A *Personality
+	B *Personality
+	// SharedFunctions are the functions found in both stacks, in A's order.
+	SharedFunctions []Function
+	// SamePosition are the functions that occupy the same position in both stacks.
+	SamePosition []Function
+}
+
+// Compare returns the functions a and b share and those in the same position of their stacks.
+func Compare(a, b *Personality) *Comparison {
+	c := &Comparison{A: a, B: b}
+
+	aFunctions, bFunctions := a.Functions(), b.Functions()
+
+	for i, fn := range aFunctions {
+		if bFunctions[i] == fn {
+			c.SamePosition = append(c.SamePosition, fn)
+		}
+
+		for _, other := range bFunctions {
+			if other == fn {
+				c.SharedFunctions = append(c.SharedFunctions, fn)
+
+				break
+			}
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,116 @@
+package mbti
+
+import (
+	"fmt"
+	"strings"
+)
+
+// relationScores rate how easy and rewarding each relation tends to be, from 0 to 1.
+var relationScores = map[RelationType]float64{
+	RelationDuality:       0.95,
+	RelationActivation:    0.85,
+	RelationSemiDuality:   0.75,
+	RelationMirror:        0.7,
+	RelationIdentity:      0.65,
+	RelationKindred:       0.6,
+	RelationMirage:        0.6,
+	RelationBusiness:      0.55,
+	RelationBenefactor:    0.5,
+	RelationBeneficiary:   0.45,
+	RelationQuasiIdentity: 0.4,
+	RelationContrary:      0.35,
+	RelationSuperEgo:      0.3,
+	RelationSupervisor:    0.25,
+	RelationSupervisee:    0.2,
+	RelationConflict:      0.1,
+}
+
+// roleReceptiveness rates, for each role in A's eight-function stack, how well
+// A receives a function B uses in that role: A welcomes help with its weaker
+// ego functions and resents having its shadow functions pushed on it.
+var roleReceptiveness = [...]float64{
+	RoleHero:      0.6,
+	RoleParent:    0.6,
+	RoleChild:     0.9,
+	RoleInferior:  1,
+	RoleNemesis:   0.3,
+	RoleCritic:    0.2,
+	RoleTrickster: 0.4,
+	RoleDemon:     0.1,
+}
+
+// FunctionInteraction is how one of B's ego functions lands on A.
+type FunctionInteraction struct {
+	Function Function
+	// Position is the position of the function in B's stack.
+	Position int
+	// Role is the role the function plays for A.
+	Role Role
+}
+
+// CompatibilityReport is how compatible personality A is with personality B.
+type CompatibilityReport struct {
+	A        *Personality
+	B        *Personality
+	Relation RelationType
+	// Interactions are B's ego functions in B's order.
+	Interactions []FunctionInteraction
+	// RelationScore rates the relation and StackScore the interactions of the functions.
+	RelationScore float64
+	StackScore    float64
+	// Score is the average of RelationScore and StackScore. All scores are
+	// between 0 and 1, higher meaning more compatible.
+	Score float64
+	// Reasons explain the score, from A's point of view.
+	Reasons []string
+}
+
+// Compatibility rates how well personality a gets along with personality b,
+// both by their relation and by how b's functions land in a's eight-function
+// stack, b's stronger functions weighing more.
+func Compatibility(a, b *Personality) *CompatibilityReport {
+	r := relationship(a, b)
+	c := &CompatibilityReport{A: a, B: b, Relation: r, RelationScore: relationScores[r]}
+
+	bFunctions := b.Functions()
+
+	var weights float64
+	for i, fn := range bFunctions {
+		// The functions of a stack are valid, so they all have a role.
+		role, _ := a.RoleOf(fn)
+		weight := float64(len(bFunctions) - i)
+
+		c.Interactions = append(c.Interactions, FunctionInteraction{Function: fn, Position: i, Role: role})
+		c.StackScore += weight * roleReceptiveness[role]
+		weights += weight
+	}
+
+	c.StackScore /= weights
+	c.Score = (c.RelationScore + c.StackScore) / 2
+
+	c.Reasons = append(c.Reasons, fmt.Sprintf("%s: %s", r, r.Description().Dynamics))
+
+	aFunctions := a.Functions()
+
+	for i, fn := range bFunctions[:PositionTertiary] {
+		for j, weak := range aFunctions[PositionTertiary:] {
+			if fn == weak {
+				c.Reasons = append(c.Reasons, fmt.Sprintf("%s's %s %s is %s's %s function, which it can lean on",
+					b, strings.ToLower(PositionNames[i]), fn, a, strings.ToLower(PositionNames[PositionTertiary+j])))
+			}
+		}
+	}
+
+	if shared := Compare(a, b).SamePosition; len(shared) > 0 && r != RelationIdentity {
+		c.Reasons = append(c.Reasons, fmt.Sprintf("Both use %s in the same position", joinFunctions(shared)))
+	}
+
+	for _, in := range c.Interactions[:PositionTertiary] {
+		if in.Role.Shadow() {
+			c.Reasons = append(c.Reasons, fmt.Sprintf("%s's %s %s plays the %s role for %s, which can feel threatening",
+				b, strings.ToLower(PositionNames[in.Position]), in.Function, strings.ToLower(in.Role.String()), a))
+		}
+	}
+
+	return c
+}
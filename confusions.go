@@ -0,0 +1,41 @@
+package mbti
+
+// confusionPairs are the pairs of types most commonly mistaken for each other,
+// usually because they share the dominant function or the indicator letters
+// that tests measure least reliably.
+var confusionPairs = [][2]string{
+	{"INFJ", "INTJ"}, {"INFJ", "INFP"}, {"INFJ", "ISFJ"}, {"INFJ", "ENFJ"},
+	{"INTJ", "INTP"}, {"INTJ", "ISTJ"},
+	{"INFP", "ISFP"}, {"INFP", "INTP"}, {"INFP", "ENFP"},
+	{"INTP", "ISTP"}, {"INTP", "ENTP"},
+	{"ENFP", "ENTP"}, {"ENFP", "ESFP"}, {"ENFP", "ENFJ"},
+	{"ENTP", "ESTP"}, {"ENTJ", "ESTJ"}, {"ENTJ", "INTJ"},
+	{"ENFJ", "ESFJ"}, {"ISFJ", "ISTJ"}, {"ISFJ", "ESFJ"},
+	{"ISTP", "ISFP"}, {"ESTP", "ESFP"}, {"ESTJ", "ISTJ"}, {"ISFP", "ESFP"},
+}
+
+// ConfusedWith returns the types commonly mistaken for the given one,
+// derived with the same model as it.
+func ConfusedWith(p *Personality) []*Personality {
+	indicator := p.Indicator()
+
+	var ret []*Personality
+
+	for _, pair := range confusionPairs {
+		other := ""
+
+		switch indicator {
+		case pair[0]:
+			other = pair[1]
+		case pair[1]:
+			other = pair[0]
+		default:
+			continue
+		}
+
+		alt, _ := FromIndicatorWithModel(other, p.Model())
+		ret = append(ret, alt)
+	}
+
+	return ret
+}
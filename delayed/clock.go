@@ -0,0 +1,29 @@
+package delayed
+
+import "time"
+
+// Clock measures the delays of the Delayed utility.
+type Clock interface {
+	// After returns a channel that receives the current time once the duration elapses.
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// DefaultClock is the clock used by the utilities that don't have one set
+// in their properties. It is the real clock, and it can be replaced by test
+// harnesses that run code creating its own utilities. It must not be changed
+// while utilities are executing.
+var DefaultClock Clock = realClock{}
+
+func clockOrDefault(c Clock) Clock {
+	if c == nil {
+		return DefaultClock
+	}
+
+	return c
+}
@@ -1,6 +1,9 @@
 package delayed
 
-import "time"
+import (
+	"context"
+	"time"
+)
 
 // Write creates a Delayed utility with a write operation queued.
 func Write(format string, args ...interface{}) *Delayed {
@@ -21,12 +24,5 @@ func DoWrite(format string, args ...interface{}) <-chan error {
 
 // DoWait executes a single wait operation.
 func DoWait(duration time.Duration) <-chan error {
-	e := make(chan error)
-	op := waitOperation{Duration: duration}
-
-	go func() {
-		e <- op.Run(nil)
-	}()
-
-	return e
+	return Wait(duration).DoContext(context.Background())
 }
@@ -0,0 +1,442 @@
+/*
+Package Delayed provides a utility that is used to
+print text to console with a 'typewriter' effect -
+letters are printed sequentially in a Delayed manner.
+
+It uses an asynchronous API based on channels so the
+caller goroutine isn't blocked, and a blocking one, Run,
+for callers that wait for the execution anyway. Both
+stop when their context is done.
+
+The operations form a queue: they can be appended while
+the utility is executing, and the durations and Skip take
+effect on the operations that are already queued.
+*/
+package delayed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Properties is used to customize the behavior of the Delayed utility.
+type Properties struct {
+	// The writer the Write operations write to. Defaults to os.Stdout.
+	// Writers that implement io.StringWriter are written strings directly.
+	Writer io.Writer
+	// The duration the Wait operations delay the execution.
+	WaitDuration time.Duration
+	// The duration it takes for a Write operation to execute.
+	PrintDuration time.Duration
+	// The unit of text written at once. Defaults to PerGrapheme.
+	Pacing Pacing
+	// If true, all delays are ignored and the operations are executed instantly.
+	IgnoreDelays bool
+	// The clock the delays are measured with. Defaults to DefaultClock.
+	// Tests can set the manual clock of the delayedtest package.
+	Clock Clock
+}
+
+type Delayed struct {
+	properties Properties
+	operations []operation
+
+	// queued is the sequence number of the last queued operation, and
+	// skipped the one of the last operation Skip was called for.
+	queued  uint64
+	skipped uint64
+	// skip is closed by Skip to interrupt the current delay.
+	skip chan struct{}
+	// wake is signaled when an operation is queued, for Serve.
+	wake chan struct{}
+
+	mu sync.Mutex
+	// runMu serializes the executions.
+	runMu sync.Mutex
+}
+
+var defaultProperties = Properties{
+	Writer: os.Stdout,
+}
+
+// New creates a Delayed utility. Customize it using
+// the Properties struct. Note that the underlying writer
+// defaults to os.Stdout and if nil is given as a writer
+// it is set back to os.Stdout.
+//
+//   d := New()
+//
+//   <-d.Write("hello", 200).
+//     Wait(500).
+//     Write("world!\n"). // the last explicit delay is used for subsequent operations
+//     Do()
+//
+// It is safe for concurrent use and it can be used for multiple executions.
+// Operations can be appended while it executes; only one execution runs at
+// a time, and it also runs the operations appended after it started.
+func New(properties ...Properties) *Delayed {
+	props := defaultProperties
+	if len(properties) > 0 {
+		props = properties[0]
+	}
+
+	if props.Writer == nil {
+		props.Writer = defaultProperties.Writer
+	}
+
+	return &Delayed{properties: props}
+}
+
+func (d *Delayed) push(op operation) {
+	d.operations = append(d.operations, op)
+
+	if d.wake == nil {
+		d.wake = make(chan struct{}, 1)
+	}
+
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (d *Delayed) nextSeq() uint64 {
+	d.queued++
+
+	return d.queued
+}
+
+func getDuration(input []time.Duration, defaultDuration time.Duration) time.Duration {
+	if len(input) > 0 {
+		return input[0]
+	}
+
+	return defaultDuration
+}
+
+// Wait appends a wait operation for execution.
+//
+// The Wait operation is putting the executing goroutine to sleep for the given duration.
+func (d *Delayed) Wait(waitDuration ...time.Duration) *Delayed {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.properties.WaitDuration = getDuration(waitDuration, d.properties.WaitDuration)
+	if d.properties.WaitDuration == 0 {
+		return d
+	}
+
+	d.push(&waitOperation{Duration: d.properties.WaitDuration, seq: d.nextSeq()})
+
+	return d
+}
+
+func popDuration(args []interface{}, defaultDuration time.Duration) (time.Duration, []interface{}) {
+	argsCount := len(args)
+
+	if argsCount > 0 {
+		lastArg, ok := args[argsCount-1].(time.Duration)
+		if ok {
+			return lastArg, args[:argsCount-1]
+		}
+	}
+
+	return defaultDuration, args
+}
+
+// Write appends a print operation for execution.
+//
+// The Write operations is writing to the given writer each grapheme of the
+// text, or each word or line depending on the pacing, with a delay between
+// each other. printDuration is the duration of the whole print operation - the
+// delay between each unit is the quotient of the division of the total duration
+// with the unit count of the text. ANSI escape sequences take no time.
+//
+// The first argument of this function is a format string for fmt.Sprintf.
+// The rest are used as format arguments. If a time.Duration is passed as the last
+// argument it is then used as the print duration. Otherwise the operation uses
+// the print duration current at the time it is executed, so changing it with
+// PrintDuration speeds up or slows down the text that is already queued.
+func (d *Delayed) Write(format string, args ...interface{}) *Delayed {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	duration, args := popDuration(args, -1)
+	op := &writeOperation{Text: fmt.Sprintf(format, args...), Writer: d.properties.Writer}
+
+	if duration >= 0 {
+		d.properties.PrintDuration = duration
+		op.Duration, op.fixed = duration, true
+	}
+
+	op.seq = d.nextSeq()
+	d.push(op)
+
+	return d
+}
+
+// unitDelay returns the delay between the units of text of the operation,
+// which is 0 if its text is to be written at once.
+func (d *Delayed) unitDelay(op *writeOperation, count int) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.properties.IgnoreDelays || op.seq <= d.skipped || count < 2 {
+		return 0
+	}
+
+	duration := d.properties.PrintDuration
+	if op.fixed {
+		duration = op.Duration
+	}
+
+	return duration / time.Duration(count)
+}
+
+// sleep waits for the given duration, unless delays are ignored or Skip is
+// called for the operation with the given sequence number.
+func (d *Delayed) sleep(ctx context.Context, duration time.Duration, seq uint64) error {
+	d.mu.Lock()
+
+	if d.properties.IgnoreDelays || seq <= d.skipped || duration <= 0 {
+		d.mu.Unlock()
+
+		return ctx.Err()
+	}
+
+	if d.skip == nil {
+		d.skip = make(chan struct{})
+	}
+
+	skip, clock := d.skip, clockOrDefault(d.properties.Clock)
+	d.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-skip:
+		return nil
+	case <-clock.After(duration):
+		return nil
+	}
+}
+
+// Skip executes the operations queued so far without any delay, including the
+// one that is executing, so their remaining text is flushed instantly. It can
+// be called at any time, for example when the user presses Enter; the
+// operations queued afterwards are delayed as usual.
+func (d *Delayed) Skip() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.skipped = d.queued
+
+	if d.skip != nil {
+		close(d.skip)
+		d.skip = nil
+	}
+}
+
+// Do executes all the queued operations in a separate goroutine, including
+// those appended while it executes.
+//
+// Use the returned channel to wait for the execution to finish and check
+// for eventual write errors.
+// Use the cancel channel to stop the execution before it finishes; unlike
+// with DoContext, stopping it this way isn't reported as an error.
+func (d *Delayed) Do(cancel ...<-chan struct{}) <-chan error {
+	errChan := make(chan error, 1)
+	ctx, stop := cancelContext(cancel)
+
+	go func() {
+		defer stop()
+
+		err := d.Run(ctx)
+		if err != nil && ctx.Err() != nil {
+			err = nil
+		}
+
+		errChan <- err
+	}()
+
+	return errChan
+}
+
+// DoContext executes all the queued operations in a separate goroutine, until
+// they are done or the context is. The returned channel receives the first
+// write error, or ctx.Err() if the execution was stopped, so callers can
+// tell a timeout from a cancellation.
+func (d *Delayed) DoContext(ctx context.Context) <-chan error {
+	errChan := make(chan error, 1)
+
+	go func() {
+		errChan <- d.Run(ctx)
+	}()
+
+	return errChan
+}
+
+// Run executes all the queued operations and returns once the queue is empty,
+// as DoContext does. The context is checked before each grapheme is written,
+// so a Write operation is interrupted in the middle of its text. If the
+// execution stops early, the operations left in the queue are discarded.
+func (d *Delayed) Run(ctx context.Context) error {
+	d.runMu.Lock()
+	defer d.runMu.Unlock()
+
+	for {
+		op := d.pop()
+		if op == nil {
+			return nil
+		}
+
+		if err := op.Run(ctx, d); err != nil {
+			d.mu.Lock()
+			d.operations = nil
+			d.mu.Unlock()
+
+			return err
+		}
+	}
+}
+
+// Serve executes the queued operations as they are appended, waiting for new
+// ones when the queue is empty, until the context is done or a write fails.
+// It is meant to run in its own goroutine for the lifetime of an output that
+// comes in bursts:
+//
+//	go d.Serve(ctx)
+//
+//	d.Write("Loading...\n")
+//	// later
+//	d.Write("Done!\n")
+func (d *Delayed) Serve(ctx context.Context) error {
+	d.mu.Lock()
+	if d.wake == nil {
+		d.wake = make(chan struct{}, 1)
+	}
+	wake := d.wake
+	d.mu.Unlock()
+
+	for {
+		if err := d.Run(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wake:
+		}
+	}
+}
+
+func (d *Delayed) pop() operation {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if len(d.operations) == 0 {
+		return nil
+	}
+
+	op := d.operations[0]
+	d.operations[0] = nil
+	d.operations = d.operations[1:]
+
+	return op
+}
+
+// cancelContext returns a context canceled when the optional cancel channel is closed.
+func cancelContext(cancel []<-chan struct{}) (context.Context, context.CancelFunc) {
+	ctx, stop := context.WithCancel(context.Background())
+	if len(cancel) == 0 || cancel[0] == nil {
+		return ctx, stop
+	}
+
+	go func() {
+		select {
+		case <-cancel[0]:
+			stop()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, stop
+}
+
+// IgnoreDelays gets or sets Properties.IgnoreDelays.
+func (d *Delayed) IgnoreDelays(new ...bool) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	value := d.properties.IgnoreDelays
+
+	if len(new) > 0 {
+		d.properties.IgnoreDelays = new[0]
+	}
+
+	return value
+}
+
+// Writer gets or sets Properties.Writer.
+func (d *Delayed) Writer(new ...io.Writer) io.Writer {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	value := d.properties.Writer
+
+	if len(new) > 0 && new[0] != nil {
+		d.properties.Writer = new[0]
+	}
+
+	return value
+}
+
+// WaitDuration gets or sets Properties.WaitDuration.
+func (d *Delayed) WaitDuration(new ...time.Duration) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	value := d.properties.WaitDuration
+
+	if len(new) > 0 {
+		d.properties.WaitDuration = new[0]
+	}
+
+	return value
+}
+
+// PrintDuration gets or sets Properties.PrintDuration. Setting it changes
+// the pace of the queued Write operations without an explicit duration,
+// even the one that is executing.
+func (d *Delayed) PrintDuration(new ...time.Duration) time.Duration {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	value := d.properties.PrintDuration
+
+	if len(new) > 0 {
+		d.properties.PrintDuration = new[0]
+	}
+
+	return value
+}
+
+// Pacing gets or sets Properties.Pacing. Setting it changes the pace
+// of the queued Write operations that haven't started yet.
+func (d *Delayed) Pacing(new ...Pacing) Pacing {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	value := d.properties.Pacing
+
+	if len(new) > 0 {
+		d.properties.Pacing = new[0]
+	}
+
+	return value
+}
@@ -0,0 +1,289 @@
+/*
+Package delayedtest helps testing code that uses the delayed package
+without real sleeps. Clock is a manual clock whose time only passes when
+the test steps it, and Recorder is a writer that records each write with
+the time it happened at, so tests can assert the exact sequence of writes:
+
+	func TestGreeting(t *testing.T) {
+		clock := delayedtest.NewClock()
+		rec := delayedtest.NewRecorder(clock)
+		d := delayed.New(delayed.Properties{Writer: rec, Clock: clock, PrintDuration: 30 * time.Millisecond})
+
+		if err := clock.Drive(d.Write("hey").Wait(time.Second).Write("!").Do()); err != nil {
+			t.Fatal(err)
+		}
+
+		delayedtest.Assert(t, rec, []delayedtest.Write{
+			{At: 0, Text: "h"},
+			{At: 10 * time.Millisecond, Text: "e"},
+			{At: 20 * time.Millisecond, Text: "y"},
+			{At: 1030 * time.Millisecond, Text: "!"},
+		})
+	}
+
+For whole commands with golden files, see the pkg/golden package.
+*/
+package delayedtest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type timer struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// Clock is a manual delayed.Clock: the channels returned by After receive
+// only once the test moves the time past their deadline with Advance, Step
+// or Drive. It is safe for concurrent use.
+type Clock struct {
+	start time.Time
+	now   time.Time
+	// timers are the pending timers, ordered by deadline.
+	timers []timer
+	// added is signaled when a timer is added.
+	added chan struct{}
+
+	mu   sync.Mutex
+	cond *sync.Cond
+}
+
+// NewClock creates a manual clock starting at the given time, or at the
+// Unix epoch if none is given.
+func NewClock(start ...time.Time) *Clock {
+	now := time.Unix(0, 0)
+	if len(start) > 0 {
+		now = start[0]
+	}
+
+	c := &Clock{start: now, now: now, added: make(chan struct{}, 1)}
+	c.cond = sync.NewCond(&c.mu)
+
+	return c
+}
+
+// After returns a channel that receives the time once the clock is moved
+// by at least d. If d isn't positive, the channel receives immediately.
+func (c *Clock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+
+		return ch
+	}
+
+	t := timer{at: c.now.Add(d), ch: ch}
+	i := sort.Search(len(c.timers), func(i int) bool { return c.timers[i].at.After(t.at) })
+	c.timers = append(c.timers, timer{})
+	copy(c.timers[i+1:], c.timers[i:])
+	c.timers[i] = t
+
+	c.cond.Broadcast()
+	c.signal()
+
+	return ch
+}
+
+func (c *Clock) signal() {
+	if len(c.timers) == 0 {
+		return
+	}
+
+	select {
+	case c.added <- struct{}{}:
+	default:
+	}
+}
+
+// Now returns the current time of the clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Elapsed returns the time passed since the clock was created.
+func (c *Clock) Elapsed() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now.Sub(c.start)
+}
+
+// Pending returns the number of timers that haven't fired yet. Timers
+// abandoned by a canceled execution are pending as well.
+func (c *Clock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.timers)
+}
+
+// BlockUntil blocks until at least n timers are pending, which is how a
+// test knows that the code under test is waiting for the clock.
+func (c *Clock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for len(c.timers) < n {
+		c.cond.Wait()
+	}
+}
+
+// Advance moves the clock forward by d, firing the timers whose deadline
+// is reached in the order of their deadlines.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target := c.now.Add(d)
+	for len(c.timers) > 0 && !c.timers[0].at.After(target) {
+		c.fire()
+	}
+
+	c.now = target
+}
+
+// Step moves the clock to the deadline of the next pending timer and fires
+// it, returning how much the time moved. It returns false if no timer is pending.
+func (c *Clock) Step() (time.Duration, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.timers) == 0 {
+		return 0, false
+	}
+
+	prev := c.now
+	c.fire()
+	c.signal()
+
+	return c.now.Sub(prev), true
+}
+
+// fire moves the clock to the first pending timer and fires it.
+func (c *Clock) fire() {
+	t := c.timers[0]
+	c.timers = c.timers[1:]
+
+	if t.at.After(c.now) {
+		c.now = t.at
+	}
+
+	t.ch <- c.now
+}
+
+// Drive steps the clock each time the code under test waits for it, until
+// the execution whose result is received from done finishes, and returns
+// its error. Pass it the channel returned by Delayed.Do or Delayed.Stream.
+func (c *Clock) Drive(done <-chan error) error {
+	return c.DriveFunc(done, nil)
+}
+
+// DriveFunc is like Drive, but calls before each time before the clock
+// moves, while the code under test waits for it. A nil function is ignored.
+func (c *Clock) DriveFunc(done <-chan error, before func()) error {
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-c.added:
+			if before != nil {
+				before()
+			}
+
+			c.Step()
+		}
+	}
+}
+
+// Write is a single write to a Recorder.
+type Write struct {
+	// At is the time of the write, relative to the creation of the clock.
+	At   time.Duration
+	Text string
+}
+
+func (w Write) String() string {
+	return fmt.Sprintf("%s %q", w.At, w.Text)
+}
+
+// Recorder is a writer that records each write and the time of its clock
+// at which it happened. Use it as the writer of a delayed.Delayed utility
+// with the same clock.
+type Recorder struct {
+	clock  *Clock
+	writes []Write
+
+	mu sync.Mutex
+}
+
+func NewRecorder(clock *Clock) *Recorder {
+	return &Recorder{clock: clock}
+}
+
+func (r *Recorder) Write(p []byte) (int, error) {
+	return r.WriteString(string(p))
+}
+
+func (r *Recorder) WriteString(s string) (int, error) {
+	at := r.clock.Elapsed()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.writes = append(r.writes, Write{At: at, Text: s})
+
+	return len(s), nil
+}
+
+// Writes returns the writes recorded so far.
+func (r *Recorder) Writes() []Write {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Write(nil), r.writes...)
+}
+
+// String returns the text written so far.
+func (r *Recorder) String() string {
+	var b strings.Builder
+	for _, w := range r.Writes() {
+		b.WriteString(w.Text)
+	}
+
+	return b.String()
+}
+
+// Assert fails the test if the writes recorded by r aren't exactly the
+// wanted ones, reporting the first one that differs.
+func Assert(tb testing.TB, r *Recorder, want []Write) {
+	tb.Helper()
+
+	got := r.Writes()
+
+	for i := 0; i < len(got) || i < len(want); i++ {
+		switch {
+		case i >= len(got):
+			tb.Errorf("write %d: missing, want %s", i, want[i])
+		case i >= len(want):
+			tb.Errorf("write %d: got %s, want none", i, got[i])
+		case got[i] != want[i]:
+			tb.Errorf("write %d: got %s, want %s", i, got[i], want[i])
+		default:
+			continue
+		}
+
+		return
+	}
+}
@@ -0,0 +1,131 @@
+package delayedtest_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tmaxmax/mbti/delayed"
+	"github.com/tmaxmax/mbti/delayed/delayedtest"
+)
+
+func newDelayed(clock *delayedtest.Clock, w *delayedtest.Recorder) *delayed.Delayed {
+	return delayed.New(delayed.Properties{Writer: w, Clock: clock, PrintDuration: 30 * time.Millisecond})
+}
+
+func TestDrive(t *testing.T) {
+	clock := delayedtest.NewClock()
+	rec := delayedtest.NewRecorder(clock)
+	d := newDelayed(clock, rec)
+
+	if err := clock.Drive(d.Write("hey").Wait(time.Second).Write("!").Do()); err != nil {
+		t.Fatal(err)
+	}
+
+	delayedtest.Assert(t, rec, []delayedtest.Write{
+		{At: 0, Text: "h"},
+		{At: 10 * time.Millisecond, Text: "e"},
+		{At: 20 * time.Millisecond, Text: "y"},
+		{At: 1030 * time.Millisecond, Text: "!"},
+	})
+}
+
+func TestAdvance(t *testing.T) {
+	clock := delayedtest.NewClock()
+	rec := delayedtest.NewRecorder(clock)
+	d := newDelayed(clock, rec)
+
+	done := d.Write("hey").Do()
+
+	// The first grapheme is written at once, then the execution waits.
+	clock.BlockUntil(1)
+	if got := rec.String(); got != "h" {
+		t.Fatalf("before advancing: got %q, want %q", got, "h")
+	}
+
+	clock.Advance(5 * time.Millisecond)
+	if got := rec.String(); got != "h" {
+		t.Fatalf("advanced before the deadline: got %q, want %q", got, "h")
+	}
+
+	clock.Advance(5 * time.Millisecond)
+	clock.BlockUntil(1)
+	if got := rec.String(); got != "he" {
+		t.Fatalf("advanced to the deadline: got %q, want %q", got, "he")
+	}
+
+	clock.Advance(time.Hour)
+	clock.BlockUntil(1)
+	if got := rec.String(); got != "hey" {
+		t.Fatalf("advanced past the deadline: got %q, want %q", got, "hey")
+	}
+
+	// The last unit is followed by its delay as well.
+	clock.Advance(10 * time.Millisecond)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	delayedtest.Assert(t, rec, []delayedtest.Write{
+		{At: 0, Text: "h"},
+		{At: 10 * time.Millisecond, Text: "e"},
+		{At: 10*time.Millisecond + time.Hour, Text: "y"},
+	})
+
+	if clock.Pending() != 0 {
+		t.Fatalf("got %d pending timers, want none", clock.Pending())
+	}
+}
+
+func TestStep(t *testing.T) {
+	clock := delayedtest.NewClock()
+
+	late, early := clock.After(2*time.Second), clock.After(time.Second)
+
+	if moved, ok := clock.Step(); !ok || moved != time.Second {
+		t.Fatalf("first step: got %s, %t, want %s, true", moved, ok, time.Second)
+	}
+
+	select {
+	case <-early:
+	default:
+		t.Fatal("the earliest timer didn't fire first")
+	}
+
+	select {
+	case <-late:
+		t.Fatal("the later timer fired early")
+	default:
+	}
+
+	if moved, ok := clock.Step(); !ok || moved != time.Second {
+		t.Fatalf("second step: got %s, %t, want %s, true", moved, ok, time.Second)
+	}
+
+	if _, ok := clock.Step(); ok {
+		t.Fatal("stepped without pending timers")
+	}
+
+	if got := clock.Elapsed(); got != 2*time.Second {
+		t.Fatalf("got %s elapsed, want %s", got, 2*time.Second)
+	}
+}
+
+func Example() {
+	clock := delayedtest.NewClock()
+	rec := delayedtest.NewRecorder(clock)
+	d := delayed.New(delayed.Properties{Writer: rec, Clock: clock, PrintDuration: 30 * time.Millisecond})
+
+	if err := clock.Drive(d.Write("hey").Wait(time.Second).Write("!").Do()); err != nil {
+		panic(err)
+	}
+
+	for _, w := range rec.Writes() {
+		fmt.Println(w)
+	}
+	// Output:
+	// 0s "h"
+	// 10ms "e"
+	// 20ms "y"
+	// 1.03s "!"
+}
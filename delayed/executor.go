@@ -0,0 +1,40 @@
+package delayed
+
+import "io"
+
+// Executor is a configuration of the Delayed utility shared by many
+// goroutines, such as the handlers of a server. Each utility it creates
+// has its own operations queue and optionally its own writer, so their
+// executions are isolated from each other.
+//
+//	e := delayed.NewExecutor(delayed.Properties{PrintDuration: time.Second})
+//
+//	func handle(ctx context.Context, w io.Writer) error {
+//	  return e.New(w).Write("hello\n").Run(ctx)
+//	}
+//
+// It is safe for concurrent use.
+type Executor struct {
+	properties Properties
+}
+
+// NewExecutor creates an executor with the given properties, defaulted as by New.
+func NewExecutor(properties ...Properties) *Executor {
+	return &Executor{properties: New(properties...).properties}
+}
+
+// New creates a Delayed utility with the properties of the executor. If a non-nil
+// writer is given, the utility writes to it instead of the configured one.
+func (e *Executor) New(writer ...io.Writer) *Delayed {
+	props := e.properties
+	if len(writer) > 0 && writer[0] != nil {
+		props.Writer = writer[0]
+	}
+
+	return &Delayed{properties: props}
+}
+
+// Properties returns the properties of the executor.
+func (e *Executor) Properties() Properties {
+	return e.properties
+}
@@ -0,0 +1,11 @@
+package delayed
+
+import "context"
+
+// operation is a generic interface for
+// tasks executed by the Delayed utility.
+type operation interface {
+	// Run executes the task with the current properties of the utility,
+	// stopping with ctx.Err() if the context is done.
+	Run(ctx context.Context, d *Delayed) error
+}
@@ -0,0 +1,15 @@
+package delayed
+
+import (
+	"context"
+	"time"
+)
+
+type waitOperation struct {
+	Duration time.Duration
+	seq      uint64
+}
+
+func (w *waitOperation) Run(ctx context.Context, d *Delayed) error {
+	return d.sleep(ctx, w.Duration, w.seq)
+}
@@ -0,0 +1,51 @@
+package delayed
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type writeOperation struct {
+	Text   string
+	Writer io.Writer
+	// Duration is the duration of the whole operation if it was given
+	// explicitly; otherwise the current PrintDuration is used.
+	Duration time.Duration
+	fixed    bool
+	seq      uint64
+}
+
+func (p *writeOperation) Run(ctx context.Context, d *Delayed) error {
+	units := d.Pacing().split(p.Text)
+	written := 0
+
+	for _, unit := range units {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		delay := d.unitDelay(p, len(units))
+		if delay == 0 {
+			// Without delays the rest of the text is written at once.
+			_, err := io.WriteString(p.Writer, p.Text[written:])
+
+			return err
+		}
+
+		if _, err := io.WriteString(p.Writer, unit); err != nil {
+			return err
+		}
+
+		written += len(unit)
+
+		// Each unit is followed by its delay, so the operation takes the whole
+		// duration and the first unit of the next one isn't written at once
+		// with the last unit of this one.
+		if err := d.sleep(ctx, delay, p.seq); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
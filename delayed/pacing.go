@@ -0,0 +1,122 @@
+package delayed
+
+import (
+	"strings"
+
+	"github.com/rivo/uniseg"
+)
+
+// Pacing is the unit of text the Delayed utility writes at once, with a
+// delay between units.
+type Pacing int
+
+const (
+	// PerGrapheme writes the text one grapheme at a time. It is the default.
+	PerGrapheme Pacing = iota
+	// PerWord writes the text one word at a time, with the whitespace that follows it.
+	PerWord
+	// PerLine writes the text one line at a time, with its line break.
+	PerLine
+)
+
+// split splits the text into the units written at once. ANSI escape sequences,
+// such as the ones that color the output, are written together with the text
+// before them, so they aren't delayed and don't count as units.
+func (p Pacing) split(text string) []string {
+	var (
+		units []string
+		start int
+		// last is the last printable grapheme of the current unit.
+		last string
+	)
+
+	for pos := 0; pos < len(text); {
+		if n := escapeLen(text[pos:]); n > 0 {
+			pos += n
+
+			continue
+		}
+
+		// The text up to the next escape sequence. A lone escape
+		// character that doesn't start a sequence is printable.
+		plain := text[pos:]
+		if plain[0] == '\x1b' {
+			plain = plain[:1]
+		} else if i := strings.IndexByte(plain, '\x1b'); i > 0 {
+			plain = plain[:i]
+		}
+
+		graphemes := uniseg.NewGraphemes(plain)
+		for graphemes.Next() {
+			g := graphemes.Str()
+
+			if last != "" && p.breaks(last, g) {
+				units = append(units, text[start:pos])
+				start = pos
+			}
+
+			last = g
+			pos += len(g)
+		}
+	}
+
+	if start < len(text) {
+		units = append(units, text[start:])
+	}
+
+	return units
+}
+
+// breaks reports whether a new unit starts with the grapheme g, given
+// the last printable grapheme before it.
+func (p Pacing) breaks(last, g string) bool {
+	switch p {
+	case PerWord:
+		return isSpace(last) && !isSpace(g)
+	case PerLine:
+		return strings.HasSuffix(last, "\n")
+	default:
+		return true
+	}
+}
+
+func isSpace(g string) bool {
+	return strings.TrimSpace(g) == ""
+}
+
+// escapeLen returns the length of the ANSI escape sequence the text starts
+// with, or 0 if it doesn't start with one.
+func escapeLen(text string) int {
+	if len(text) < 2 || text[0] != '\x1b' {
+		return 0
+	}
+
+	switch text[1] {
+	case '[':
+		// CSI: parameter and intermediate bytes, ended by a final byte.
+		for i := 2; i < len(text); i++ {
+			if c := text[i]; c >= 0x40 && c <= 0x7e {
+				return i + 1
+			} else if c < 0x20 || c > 0x3f {
+				return 0
+			}
+		}
+	case ']':
+		// OSC: ended by BEL or by ESC \.
+		for i := 2; i < len(text); i++ {
+			if text[i] == '\a' {
+				return i + 1
+			}
+
+			if text[i] == '\x1b' && i+1 < len(text) && text[i+1] == '\\' {
+				return i + 2
+			}
+		}
+	default:
+		if c := text[1]; c >= 0x40 && c <= 0x5f {
+			return 2
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,154 @@
+package delayed
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+)
+
+// StreamOptions configures how Stream paces text that arrives in chunks
+// of irregular size and timing, such as the output of a language model.
+type StreamOptions struct {
+	// GraphemeDelay is the delay between graphemes at normal pace. Defaults to 30ms.
+	// If Properties.Pacing is PerWord or PerLine, it is the delay between words or
+	// lines instead, and the watermarks count words or lines.
+	GraphemeDelay time.Duration
+	// LowWatermark is the number of buffered graphemes below which printing slows
+	// down to half the normal pace while more text is expected, so that short pauses
+	// of the source don't stall the output. Defaults to 16.
+	LowWatermark int
+	// HighWatermark is the number of buffered graphemes above which printing speeds
+	// up proportionally to the backlog, so the output catches up with the source.
+	// Defaults to 256.
+	HighWatermark int
+}
+
+func (o StreamOptions) withDefaults() StreamOptions {
+	if o.GraphemeDelay <= 0 {
+		o.GraphemeDelay = 30 * time.Millisecond
+	}
+
+	if o.LowWatermark <= 0 {
+		o.LowWatermark = 16
+	}
+
+	if o.HighWatermark <= o.LowWatermark {
+		o.HighWatermark = 256
+	}
+
+	return o
+}
+
+// delay returns the delay before the next grapheme, given the number of buffered
+// graphemes and whether more chunks may arrive.
+func (o StreamOptions) delay(buffered int, open bool) time.Duration {
+	switch {
+	case buffered > o.HighWatermark:
+		return o.GraphemeDelay * time.Duration(o.HighWatermark) / time.Duration(buffered)
+	case open && buffered < o.LowWatermark:
+		return o.GraphemeDelay * 2
+	default:
+		return o.GraphemeDelay
+	}
+}
+
+// Stream writes the chunks received from the channel with a typewriter effect
+// until the channel is closed, smoothing out the pace at which they arrive.
+// The queued operations are not executed, so call Do first if there are any.
+//
+//	chunks := make(chan string)
+//	go func() {
+//	  defer close(chunks)
+//	  for token := range llm.Tokens() {
+//	    chunks <- token
+//	  }
+//	}()
+//
+//	<-d.Stream(chunks, StreamOptions{})
+//
+// If delays are ignored, chunks are written as soon as they arrive.
+// Use the cancel channel to stop the execution before the channel is closed;
+// unlike with StreamContext, stopping it this way isn't reported as an error.
+func (d *Delayed) Stream(chunks <-chan string, opts StreamOptions, cancel ...<-chan struct{}) <-chan error {
+	errChan := make(chan error, 1)
+	ctx, stop := cancelContext(cancel)
+
+	go func() {
+		defer stop()
+
+		err := <-d.StreamContext(ctx, chunks, opts)
+		if err != nil && ctx.Err() != nil {
+			err = nil
+		}
+
+		errChan <- err
+	}()
+
+	return errChan
+}
+
+// StreamContext is Stream stopping when the context is done, in which case
+// the returned channel receives ctx.Err().
+func (d *Delayed) StreamContext(ctx context.Context, chunks <-chan string, opts StreamOptions) <-chan error {
+	errChan := make(chan error, 1)
+
+	go func() {
+		d.runMu.Lock()
+		defer d.runMu.Unlock()
+
+		d.mu.Lock()
+		props := d.properties
+		d.mu.Unlock()
+
+		errChan <- stream(ctx, props, chunks, opts.withDefaults())
+	}()
+
+	return errChan
+}
+
+func stream(ctx context.Context, props Properties, chunks <-chan string, opts StreamOptions) error {
+	var (
+		buffer []string
+		tick   <-chan time.Time
+	)
+
+	for chunks != nil || len(buffer) > 0 {
+		if props.IgnoreDelays && len(buffer) > 0 {
+			if _, err := io.WriteString(props.Writer, strings.Join(buffer, "")); err != nil {
+				return err
+			}
+
+			buffer = nil
+		}
+
+		// The timer is kept while chunks arrive, so a fast source can't hold back the output.
+		if tick == nil && len(buffer) > 0 {
+			tick = clockOrDefault(props.Clock).After(opts.delay(len(buffer), chunks != nil))
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case chunk, ok := <-chunks:
+			if !ok {
+				chunks = nil
+
+				continue
+			}
+
+			// A word or line cut by the end of the chunk is written as two units.
+			buffer = append(buffer, props.Pacing.split(chunk)...)
+		case <-tick:
+			tick = nil
+
+			if _, err := io.WriteString(props.Writer, buffer[0]); err != nil {
+				return err
+			}
+
+			buffer = buffer[1:]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package mbti
+
+import (
+	"fmt"
+	"strings"
+)
+
+var stackPositions = [...]string{"Dominant", "Auxiliary", "Tertiary", "Inferior"}
+
+// RenderStack draws the function stack of the personality as an ASCII diagram.
+// Bars show the relative strength of each position.
+//
+//	+-------------------------+
+//	| INTJ                    |
+//	+-------------------------+
+//	| 1 Dominant  Ni ######## |
+//	| 2 Auxiliary Te ######   |
+//	| 3 Tertiary  Fi ####     |
+//	| 4 Inferior  Se ##       |
+//	+-------------------------+
+func RenderStack(p *Personality) string {
+	functions := p.Functions()
+	lines := make([]string, 0, len(functions))
+
+	for i, fn := range functions {
+		lines = append(lines, fmt.Sprintf("%d %-9s %s %s", i+1, stackPositions[i], fn, strings.Repeat("#", 2*(len(functions)-i))))
+	}
+
+	return box(p.String(), lines)
+}
+
+// RenderComparison draws the function stacks of the compared personalities
+// side by side. Functions in the same position are joined by "=", and the
+// shared functions found in different positions are listed below.
+//
+//	+------------------------+
+//	| INTJ vs ENFP           |
+//	+------------------------+
+//	| Dominant    Ni      Ne |
+//	| Auxiliary   Te      Fi |
+//	| Tertiary    Fi      Te |
+//	| Inferior    Se      Si |
+//	+------------------------+
+//	| Te: 2 <-> 3            |
+//	| Fi: 3 <-> 2            |
+//	+------------------------+
+func RenderComparison(c *Comparison) string {
+	a, b := c.A.Functions(), c.B.Functions()
+	lines := make([]string, 0, len(a))
+
+	for i := range a {
+		link := "  "
+		if a[i] == b[i] {
+			link = "=="
+		}
+
+		lines = append(lines, fmt.Sprintf("%-9s   %s  %s  %s", stackPositions[i], a[i], link, b[i]))
+	}
+
+	var shared []string
+
+	for _, fn := range c.SharedFunctions {
+		i, j := positionOf(a, fn), positionOf(b, fn)
+		if i != j {
+			shared = append(shared, fmt.Sprintf("%s: %d <-> %d", fn, i+1, j+1))
+		}
+	}
+
+	return box(fmt.Sprintf("%s vs %s", c.A, c.B), lines, shared)
+}
+
+func positionOf(functions []Function, fn Function) int {
+	for i, f := range functions {
+		if f == fn {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// box frames the title and the non-empty sections of lines in an ASCII box.
+func box(title string, sections ...[]string) string {
+	width := len(title)
+	for _, lines := range sections {
+		for _, l := range lines {
+			if len(l) > width {
+				width = len(l)
+			}
+		}
+	}
+
+	border := "+" + strings.Repeat("-", width+2) + "+\n"
+
+	ret := &strings.Builder{}
+	ret.WriteString(border)
+	fmt.Fprintf(ret, "| %-*s |\n", width, title)
+	ret.WriteString(border)
+
+	for _, lines := range sections {
+		if len(lines) == 0 {
+			continue
+		}
+
+		for _, l := range lines {
+			fmt.Fprintf(ret, "| %-*s |\n", width, l)
+		}
+
+		ret.WriteString(border)
+	}
+
+	return ret.String()
+}
+
+// DerivationSteps explains, step by step, how the functions of the
+// personality are derived from its indicator.
+func DerivationSteps(p *Personality) []string {
+	indicator := p.Indicator()
+	focus, perceiving, judging, tactics := indicator[0], indicator[1], indicator[2], indicator[3]
+
+	judgingAttitude, perceivingAttitude := "extraverted", "introverted"
+	if tactics == tacticProspecting {
+		judgingAttitude, perceivingAttitude = perceivingAttitude, judgingAttitude
+	}
+
+	leading := "extraverted"
+	if Focus(focus) == FocusInternal {
+		leading = "introverted"
+	}
+
+	judgingFunction, perceivingFunction := p.primary, p.auxiliary
+	if p.primary.IsProspecting() {
+		judgingFunction, perceivingFunction = perceivingFunction, judgingFunction
+	}
+
+	return []string{
+		fmt.Sprintf("%c: the judging letter %c is %s (%s) and the perceiving letter %c is %s (%s).",
+			tactics, judging, judgingAttitude, judgingFunction, perceiving, perceivingAttitude, perceivingFunction),
+		fmt.Sprintf("%c: the %s function leads, so %s is dominant and %s auxiliary.", focus, leading, p.primary, p.auxiliary),
+		fmt.Sprintf("The tertiary function is the opposite of the %s, in the %s's attitude: %s.", oppositeSource(p, p.tertiary), attitudeSource(p, p.tertiary), p.tertiary),
+		fmt.Sprintf("The inferior function is the opposite of the %s, in the %s's attitude: %s.", oppositeSource(p, p.inferior), attitudeSource(p, p.inferior), p.inferior),
+	}
+}
+
+// attitudeSource names the leading function whose attitude the function has,
+// which depends on the model of the personality.
+func attitudeSource(p *Personality, fn Function) string {
+	if fn.focus == p.primary.focus {
+		return "dominant"
+	}
+
+	return "auxiliary"
+}
+
+// oppositeSource names the leading function the function is the opposite of,
+// which depends on the model of the personality.
+func oppositeSource(p *Personality, fn Function) string {
+	if fn.kind == invertKind(p.primary.kind) {
+		return "dominant"
+	}
+
+	return "auxiliary"
+}
+
+// flowWidth is the width of the text in the nodes of the flowchart.
+const flowWidth = 40
+
+// RenderFlow draws the steps returned by DerivationSteps as a flowchart,
+// from the indicator to the function stack.
+//
+//	+------------------------------------------+
+//	| INTJ                                     |
+//	+------------------------------------------+
+//	                     |
+//	                     v
+//	+------------------------------------------+
+//	| J: the judging letter T is extraverted   |
+//	| (Te) and the perceiving letter N is      |
+//	| introverted (Ni).                        |
+//	+------------------------------------------+
+//	...
+func RenderFlow(p *Personality) string {
+	nodes := [][]string{{p.String()}}
+	for _, step := range DerivationSteps(p) {
+		nodes = append(nodes, wrap(step, flowWidth))
+	}
+
+	nodes = append(nodes, []string{fmt.Sprintf("%s > %s > %s > %s", p.primary, p.auxiliary, p.tertiary, p.inferior)})
+
+	border := "+" + strings.Repeat("-", flowWidth+2) + "+\n"
+	arrow := strings.Repeat(" ", (flowWidth+4)/2)
+
+	ret := &strings.Builder{}
+
+	for i, node := range nodes {
+		if i > 0 {
+			fmt.Fprintf(ret, "%s|\n%sv\n", arrow, arrow)
+		}
+
+		ret.WriteString(border)
+		for _, l := range node {
+			fmt.Fprintf(ret, "| %-*s |\n", flowWidth, l)
+		}
+		ret.WriteString(border)
+	}
+
+	return ret.String()
+}
+
+// wrap splits the text into lines no longer than width, at spaces.
+func wrap(text string, width int) []string {
+	var lines []string
+
+	line := ""
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+
+		if line != "" {
+			line += " "
+		}
+
+		line += word
+	}
+
+	return append(lines, line)
+}
@@ -0,0 +1,88 @@
+package mbti
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Differentiator lists what tells two personality types apart.
+type Differentiator struct {
+	A *Personality
+	B *Personality
+	// Letters are the positions of the indicator letters in which the types differ.
+	Letters []int
+	// OnlyA and OnlyB are the functions found in only one of the stacks.
+	OnlyA []Function
+	OnlyB []Function
+	// Moved are the functions found in both stacks at different positions, in A's order.
+	Moved []Function
+}
+
+// Differentiate compares two personality types to find what distinguishes them,
+// which helps deciding between types someone is torn between.
+func Differentiate(a, b *Personality) *Differentiator {
+	d := &Differentiator{A: a, B: b}
+
+	ia, ib := a.String(), b.String()
+	for i := range ia {
+		if ia[i] != ib[i] {
+			d.Letters = append(d.Letters, i)
+		}
+	}
+
+	aFunctions, bFunctions := a.Functions(), b.Functions()
+
+	for i, fn := range aFunctions {
+		switch j := positionOf(bFunctions, fn); {
+		case j < 0:
+			d.OnlyA = append(d.OnlyA, fn)
+		case j != i:
+			d.Moved = append(d.Moved, fn)
+		}
+	}
+
+	for _, fn := range bFunctions {
+		if positionOf(aFunctions, fn) < 0 {
+			d.OnlyB = append(d.OnlyB, fn)
+		}
+	}
+
+	return d
+}
+
+// Hints describes the differences in sentences, most telling first.
+func (d *Differentiator) Hints() []string {
+	var hints []string
+
+	if d.A.primary != d.B.primary {
+		hints = append(hints, fmt.Sprintf("%s leads with %s (%s), %s with %s (%s).",
+			d.A, d.A.primary, d.A.primary.Description().Name, d.B, d.B.primary, d.B.primary.Description().Name))
+	}
+
+	aFunctions, bFunctions := d.A.Functions(), d.B.Functions()
+
+	for _, fn := range d.Moved {
+		hints = append(hints, fmt.Sprintf("%s is the %s function of %s and the %s function of %s.", fn,
+			strings.ToLower(PositionNames[positionOf(aFunctions, fn)]), d.A,
+			strings.ToLower(PositionNames[positionOf(bFunctions, fn)]), d.B))
+	}
+
+	if len(d.OnlyA) > 0 {
+		hints = append(hints, fmt.Sprintf("Only %s uses %s.", d.A, joinFunctions(d.OnlyA)))
+	}
+
+	if len(d.OnlyB) > 0 {
+		hints = append(hints, fmt.Sprintf("Only %s uses %s.", d.B, joinFunctions(d.OnlyB)))
+	}
+
+	return hints
+}
+
+func joinFunctions(functions []Function) string {
+	names := make([]string, 0, len(functions))
+	for _, fn := range functions {
+		names = append(names, fn.String())
+	}
+
+	return strings.Join(names, " and ")
+}
@@ -0,0 +1,92 @@
+package mbti
+
+import "unicode"
+
+// DistanceReport measures how far apart two personalities are.
+type DistanceReport struct {
+	A *Personality
+	B *Personality
+	// Letters is the number of letters in which the indicators differ, from 0 to 4.
+	Letters int
+	// Stack is the edit distance between the function stacks, from 0 to 4.
+	// Swapping two adjacent functions counts as a single edit, so INTJ
+	// (Ni Te Fi Se) is at distance 2 from ENTJ (Te Ni Se Fi).
+	Stack int
+	// Score is the average of both distances, normalized from 0 for the same type to 1.
+	Score float64
+}
+
+// Distance returns how far apart a and b are by their letters and function stacks.
+func Distance(a, b *Personality) *DistanceReport {
+	d := &DistanceReport{A: a, B: b}
+
+	aIndicator, bIndicator := a.Indicator(), b.Indicator()
+	for i := range aIndicator {
+		if aIndicator[i] != bIndicator[i] {
+			d.Letters++
+		}
+	}
+
+	d.Stack = levenshtein(stackString(a), stackString(b))
+	d.Score = (float64(d.Letters)/4 + float64(d.Stack)/4) / 2
+
+	return d
+}
+
+// stackString writes each function of the stack as a single letter, the kind
+// in lowercase if the function is introverted, so stacks can be compared as strings.
+func stackString(p *Personality) string {
+	ret := make([]rune, 0, 4)
+	for _, fn := range p.Functions() {
+		if fn.IsIntroverted() {
+			ret = append(ret, unicode.ToLower(rune(fn.kind)))
+		} else {
+			ret = append(ret, rune(fn.kind))
+		}
+	}
+
+	return string(ret)
+}
+
+// OverlapReport measures how many functions two personalities share.
+type OverlapReport struct {
+	A *Personality
+	B *Personality
+	// Shared is the number of functions found in both stacks, from 0 to 4.
+	Shared int
+	// SamePosition is the number of functions in the same position of both stacks.
+	SamePosition int
+	// Score weighs each shared function by its positions in both stacks, the
+	// dominant counting most, normalized from 0 to 1 for the same stack.
+	Score float64
+}
+
+// FunctionOverlap returns how many functions a and b share and how prominent they are in both stacks.
+func FunctionOverlap(a, b *Personality) *OverlapReport {
+	o := &OverlapReport{A: a, B: b}
+
+	aFunctions, bFunctions := a.Functions(), b.Functions()
+
+	var weight, total int
+
+	for i, fn := range aFunctions {
+		total += (len(aFunctions) - i) * (len(aFunctions) - i)
+
+		for j, other := range bFunctions {
+			if other != fn {
+				continue
+			}
+
+			o.Shared++
+			weight += (len(aFunctions) - i) * (len(bFunctions) - j)
+
+			if i == j {
+				o.SamePosition++
+			}
+		}
+	}
+
+	o.Score = float64(weight) / float64(total)
+
+	return o
+}
@@ -0,0 +1,31 @@
+/*
+Package mbti models the sixteen Myers-Briggs personality types through their
+cognitive functions.
+
+A Personality is created from an indicator, such as FromIndicator("INTJ"), or
+from its dominant and auxiliary functions, and Parse accepts both forms. Its
+function stack is derived by a Model, which is ModelGrant unless another one
+is chosen. All and Filter enumerate the types.
+
+# Layout
+
+The module is split into packages with a stable API:
+
+  - mbti, this package, is the core: functions, personalities, models,
+    parsing, notations and locales, as well as how types get along
+    (intertype relations, compatibility and team analysis) and their
+    metadata (nicknames, descriptions and their providers).
+  - mbti/quiz is the questionnaire that determines a type.
+  - mbti/delayed is the typewriter utility used by the command line tool,
+    and mbti/delayed/delayedtest helps testing code that uses it.
+
+The relations and the metadata stay in the core because methods of
+Personality, such as Partner and Nickname, depend on them, and a separate
+package would import this one in turn.
+
+The command line tool lives in mbti/cmd and is not importable. The packages
+under mbti/pkg are integrations built on the ones above, except for
+pkg/assessment and pkg/delayed, which are deprecated forwarders to mbti/quiz
+and mbti/delayed.
+*/
+package mbti
@@ -0,0 +1,170 @@
+package mbti
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MarshalText encodes the function as its name, such as "Ne".
+func (f Function) MarshalText() ([]byte, error) {
+	if !isValidFunction(f) {
+		return nil, fmt.Errorf("%w: can't encode the zero value", ErrInvalidFunction)
+	}
+
+	return []byte(f.String()), nil
+}
+
+// UnmarshalText decodes a function name, such as "Ne" or "ne".
+func (f *Function) UnmarshalText(text []byte) error {
+	if len(text) != 2 {
+		return fmt.Errorf("%w %q", ErrInvalidFunctionsString, text)
+	}
+
+	fn, err := functionFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*f = fn
+
+	return nil
+}
+
+// MarshalJSON encodes the function as a JSON string of its name.
+func (f Function) MarshalJSON() ([]byte, error) {
+	text, err := f.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(string(text))
+}
+
+func (f *Function) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return f.UnmarshalText([]byte(s))
+}
+
+// MarshalText encodes the personality as its indicator, with the identity suffix if known.
+func (p *Personality) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes a personality from any input accepted by Parse.
+func (p *Personality) UnmarshalText(text []byte) error {
+	parsed, err := parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*p = *parsed
+
+	return nil
+}
+
+type personalityJSON struct {
+	Indicator string     `json:"indicator,omitempty"`
+	Functions []Function `json:"functions,omitempty"`
+	Model     string     `json:"model,omitempty"`
+}
+
+// MarshalJSON encodes the personality as an object with its indicator and
+// its function stack: {"indicator":"ENFP","functions":["Ne","Fi","Te","Si"]}.
+// The name of the model is added if it isn't ModelGrant, as in "model":"myers".
+func (p *Personality) MarshalJSON() ([]byte, error) {
+	v := personalityJSON{Indicator: p.String(), Functions: p.Functions()}
+	if m := p.Model(); m != ModelGrant {
+		v.Model = m.Name()
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON decodes the object produced by MarshalJSON. Either the
+// indicator or the functions may be omitted, in which case the personality
+// is created from the dominant functions; if both are present, they must
+// describe the same type. The personality is derived with the given model,
+// or else with the built-in model whose stack matches the functions, ModelGrant
+// first. The text form, as a JSON string, is also accepted.
+func (p *Personality) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return p.UnmarshalText([]byte(s))
+	}
+
+	var v personalityJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	var (
+		parsed *Personality
+		err    error
+	)
+
+	switch {
+	case v.Indicator != "":
+		parsed, err = FromIndicator(v.Indicator)
+	case len(v.Functions) >= 2:
+		parsed, err = FromDominantFunctions(v.Functions[0], v.Functions[1])
+	default:
+		err = fmt.Errorf("%w: expected an indicator or the dominant functions", ErrInvalidInput)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	models := Models[:]
+	if v.Model != "" {
+		m, err := LookupModel(v.Model)
+		if err != nil {
+			return err
+		}
+
+		models = []Model{m}
+	}
+
+	for _, m := range models {
+		if candidate := parsed.WithModel(m); matchesFunctions(candidate, v.Functions) {
+			*p = *candidate
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s don't match %s", ErrInvalidFunctions, formatFunctionList(v.Functions), parsed)
+}
+
+// matchesFunctions reports whether the given functions are the first ones of the stack of p.
+func matchesFunctions(p *Personality, functions []Function) bool {
+	return len(functions) == 0 || sameFunctions(p.Functions()[:min(len(functions), 4)], functions)
+}
+
+func sameFunctions(a, b []Function) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func formatFunctionList(functions []Function) string {
+	names := make([]string, 0, len(functions))
+	for _, fn := range functions {
+		names = append(names, fn.String())
+	}
+
+	return strings.Join(names, " ")
+}
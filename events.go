@@ -0,0 +1,134 @@
+package mbti
+
+import (
+	"sync"
+	"time"
+)
+
+// Event is something that happened in the library, such as a type being
+// parsed. Applications observe events for analytics instead of wrapping
+// every call.
+type Event struct {
+	// Name identifies the kind of event, for example EventTypeParsed.
+	Name string
+	Time time.Time
+	// Payload holds the details of the event. Its type depends on the name:
+	// TypeParsed for EventTypeParsed and RelationComputed for EventRelationComputed.
+	Payload interface{}
+}
+
+const (
+	EventTypeParsed       = "type.parsed"
+	EventRelationComputed = "relation.computed"
+)
+
+// TypeParsed is the payload of EventTypeParsed, published when Parse or ParseLocalized succeeds.
+type TypeParsed struct {
+	Input       string
+	Personality *Personality
+}
+
+// RelationComputed is the payload of EventRelationComputed, published by Relationship.
+type RelationComputed struct {
+	A        *Personality
+	B        *Personality
+	Relation RelationType
+}
+
+// Observer receives events. It is called synchronously, on the goroutine
+// that caused the event, so it should return quickly.
+type Observer func(Event)
+
+type subscription struct {
+	observe Observer
+}
+
+var (
+	subscriptions   []*subscription
+	subscriptionsMu sync.RWMutex
+)
+
+// Subscribe calls the observer for every event published from now on,
+// until the returned function is called.
+func Subscribe(o Observer) (unsubscribe func()) {
+	s := &subscription{observe: o}
+
+	subscriptionsMu.Lock()
+	subscriptions = append(subscriptions, s)
+	subscriptionsMu.Unlock()
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			subscriptionsMu.Lock()
+			defer subscriptionsMu.Unlock()
+
+			for i, other := range subscriptions {
+				if other == s {
+					subscriptions = append(subscriptions[:i:i], subscriptions[i+1:]...)
+
+					break
+				}
+			}
+		})
+	}
+}
+
+// SubscribeChan delivers events through a channel with the given buffer size,
+// so that they can be handled on another goroutine. Events are dropped while
+// the buffer is full, so a slow consumer never blocks the library. The
+// channel is closed after the returned function is called.
+func SubscribeChan(size int) (<-chan Event, func()) {
+	events := make(chan Event, size)
+
+	var mu sync.Mutex
+	closed := false
+
+	unsubscribe := Subscribe(func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if closed {
+			return
+		}
+
+		select {
+		case events <- e:
+		default:
+		}
+	})
+
+	return events, func() {
+		unsubscribe()
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if !closed {
+			closed = true
+			close(events)
+		}
+	}
+}
+
+// Publish sends an event to all observers. The time of the event is set
+// to the current time if it is zero. Packages building on this one publish
+// their own events with it.
+func Publish(e Event) {
+	subscriptionsMu.RLock()
+	subs := subscriptions
+	subscriptionsMu.RUnlock()
+
+	if len(subs) == 0 {
+		return
+	}
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	for _, s := range subs {
+		s.observe(e)
+	}
+}
@@ -3,37 +3,70 @@ package mbti
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"unicode"
 )
 
+// Focus is the attitude of a function, written as the second letter of its name.
+type Focus rune
+
 const (
-	focusInternal = 'I'
-	focusExternal = 'E'
+	FocusInternal Focus = 'I'
+	FocusExternal Focus = 'E'
 )
 
+func (f Focus) String() string {
+	switch f {
+	case FocusInternal:
+		return "Introverted"
+	case FocusExternal:
+		return "Extraverted"
+	default:
+		return fmt.Sprintf("Focus(%q)", rune(f))
+	}
+}
+
+// Kind is what a function perceives or judges, written as the first letter of its name.
+type Kind rune
+
 const (
-	KindFeeling   = 'F'
-	KindThinking  = 'T'
-	KindSensation = 'S'
-	KindIntuition = 'N'
+	KindFeeling   Kind = 'F'
+	KindThinking  Kind = 'T'
+	KindSensation Kind = 'S'
+	KindIntuition Kind = 'N'
 )
 
+var kindNames = map[Kind]string{
+	KindFeeling:   "Feeling",
+	KindThinking:  "Thinking",
+	KindSensation: "Sensation",
+	KindIntuition: "Intuition",
+}
+
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("Kind(%q)", rune(k))
+}
+
 const (
 	tacticJudging     = 'J'
 	tacticProspecting = 'P'
 )
 
-func invertFocus(focus rune) rune {
+func invertFocus(focus Focus) Focus {
 	switch focus {
-	case focusInternal:
-		return focusExternal
+	case FocusInternal:
+		return FocusExternal
 	default:
-		return focusInternal
+		return FocusInternal
 	}
 }
 
-func invertKind(function rune) rune {
-	switch function {
+func invertKind(kind Kind) Kind {
+	switch kind {
 	case KindFeeling:
 		return KindThinking
 	case KindThinking:
@@ -45,9 +78,41 @@ func invertKind(function rune) rune {
 	}
 }
 
+// Function is a cognitive function, such as Ni. Functions are comparable and
+// can be used as map keys. The zero value is not a valid function; functions
+// are obtained from the predefined ones, NewFunction or by parsing.
 type Function struct {
-	focus rune
-	kind  rune
+	focus Focus
+	kind  Kind
+}
+
+// The eight cognitive functions.
+var (
+	Ne = Function{focus: FocusExternal, kind: KindIntuition}
+	Ni = Function{focus: FocusInternal, kind: KindIntuition}
+	Se = Function{focus: FocusExternal, kind: KindSensation}
+	Si = Function{focus: FocusInternal, kind: KindSensation}
+	Te = Function{focus: FocusExternal, kind: KindThinking}
+	Ti = Function{focus: FocusInternal, kind: KindThinking}
+	Fe = Function{focus: FocusExternal, kind: KindFeeling}
+	Fi = Function{focus: FocusInternal, kind: KindFeeling}
+)
+
+// AllFunctions lists the eight functions, perceiving ones first and each
+// introverted one before its extraverted counterpart.
+var AllFunctions = [...]Function{Ni, Ne, Si, Se, Ti, Te, Fi, Fe}
+
+var ErrInvalidFunction = errors.New("invalid function")
+
+// NewFunction returns the function of the given kind and focus, such as
+// NewFunction(KindIntuition, FocusInternal) for Ni.
+func NewFunction(kind Kind, focus Focus) (Function, error) {
+	fn := Function{focus: focus, kind: kind}
+	if !isValidFunction(fn) {
+		return Function{}, fmt.Errorf("%w: kind %q and focus %q", ErrInvalidFunction, rune(kind), rune(focus))
+	}
+
+	return fn, nil
 }
 
 func (f Function) IsJudging() bool {
@@ -59,11 +124,16 @@ func (f Function) IsProspecting() bool {
 }
 
 func (f Function) IsIntroverted() bool {
-	return f.focus == focusInternal
+	return f.focus == FocusInternal
 }
 
 func (f Function) IsExtroverted() bool {
-	return f.focus == focusExternal
+	return f.focus == FocusExternal
+}
+
+// IsValid reports whether the function is one of the eight functions, which the zero value isn't.
+func (f Function) IsValid() bool {
+	return isValidFunction(f)
 }
 
 func (f Function) invertFocus() Function {
@@ -74,17 +144,17 @@ func (f Function) invertFocus() Function {
 }
 
 func (f Function) String() string {
-	if f.IsIntroverted() {
-		return string(f.kind) + string(unicode.ToLower(focusInternal))
-	}
-
-	return string(f.kind) + string(unicode.ToLower(focusExternal))
+	return string(f.kind) + string(unicode.ToLower(rune(f.focus)))
 }
 
-func (f Function) Kind() rune {
+func (f Function) Kind() Kind {
 	return f.kind
 }
 
+func (f Function) Focus() Focus {
+	return f.focus
+}
+
 var ErrInvalidFunctionsString = errors.New("invalid function string")
 
 func isValidFunction(function Function) bool {
@@ -93,8 +163,8 @@ func isValidFunction(function Function) bool {
 
 func functionFromStringUnchecked(s string) Function {
 	return Function{
-		focus: unicode.ToUpper(rune(s[1])),
-		kind:  unicode.ToUpper(rune(s[0])),
+		focus: Focus(unicode.ToUpper(rune(s[1]))),
+		kind:  Kind(unicode.ToUpper(rune(s[0]))),
 	}
 }
 
@@ -143,3 +213,42 @@ func FunctionsFromString(s string) ([]Function, error) {
 
 	return funcs, nil
 }
+
+// JoinFunctions writes the functions separated by sep, as in "Ni Te Fi Se".
+func JoinFunctions(functions []Function, sep string) string {
+	names := make([]string, 0, len(functions))
+	for _, fn := range functions {
+		names = append(names, fn.String())
+	}
+
+	return strings.Join(names, sep)
+}
+
+// AxisPartner returns the function on the other end of the function's axis,
+// which has the opposite kind and focus: Ni and Se, Ti and Fe and so on.
+func (f Function) AxisPartner() Function {
+	return f.invert()
+}
+
+// Positions of the functions in a personality's stack.
+const (
+	PositionDominant = iota
+	PositionAuxiliary
+	PositionTertiary
+	PositionInferior
+)
+
+// PositionNames are the names of the stack positions.
+var PositionNames = [...]string{"Dominant", "Auxiliary", "Tertiary", "Inferior"}
+
+type FunctionDescription struct {
+	Name    string
+	Summary string
+	// Positions describe how the function shows up in each position of the stack.
+	Positions [4]string
+}
+
+// Description returns a description of the function.
+func (f Function) Description() FunctionDescription {
+	return functionDescriptions[f.String()]
+}
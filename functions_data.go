@@ -0,0 +1,84 @@
+package mbti
+
+var functionDescriptions = map[string]FunctionDescription{
+	"Ni": {
+		Name:    "Introverted Intuition",
+		Summary: "Synthesizes impressions into a single underlying pattern and foresees where things are heading.",
+		Positions: [4]string{
+			"A guiding inner vision; strong hunches about the future that shape long-term goals.",
+			"Supports decisions with a sense of their implications and a focus on what matters most.",
+			"Occasional flashes of insight or foreboding, trusted with some hesitation.",
+			"Under stress, fixation on dark predictions and hidden meanings in small signs.",
+		},
+	},
+	"Ne": {
+		Name:    "Extraverted Intuition",
+		Summary: "Explores possibilities and connections in the outer world, jumping from one idea to the next.",
+		Positions: [4]string{
+			"Endless brainstorming, curiosity and enthusiasm for anything new or unexplored.",
+			"Opens up alternatives and creative angles for the dominant function to work with.",
+			"A playful taste for novelty and what-ifs that grows with age.",
+			"Under stress, catastrophic imagining of every way things could go wrong.",
+		},
+	},
+	"Si": {
+		Name:    "Introverted Sensing",
+		Summary: "Compares the present with detailed past experience and values what is known and reliable.",
+		Positions: [4]string{
+			"A rich memory of experiences, routines and traditions that anchor every decision.",
+			"Brings accuracy, consistency and attention to proven methods.",
+			"A growing appreciation for comfort, habits and lessons from the past.",
+			"Under stress, obsession with bodily sensations or minor details and rigid routines.",
+		},
+	},
+	"Se": {
+		Name:    "Extraverted Sensing",
+		Summary: "Engages directly with the physical world, reacting to what is happening here and now.",
+		Positions: [4]string{
+			"Vivid awareness of the surroundings and a love for action, risk and sensory experience.",
+			"Grounds decisions in concrete facts and lets them be carried out on the spot.",
+			"A taste for physical activity, aesthetics and spontaneous fun.",
+			"Under stress, impulsive overindulgence in food, shopping or other sensations.",
+		},
+	},
+	"Ti": {
+		Name:    "Introverted Thinking",
+		Summary: "Builds precise internal frameworks and judges ideas by their logical consistency.",
+		Positions: [4]string{
+			"A drive to understand how things work, with exact definitions and independent analysis.",
+			"Checks the dominant function's ideas for logical flaws and refines them.",
+			"An emerging appetite for analysis and categorizing, sometimes used to win arguments.",
+			"Under stress, harsh and nitpicking criticism of oneself and others.",
+		},
+	},
+	"Te": {
+		Name:    "Extraverted Thinking",
+		Summary: "Organizes the outer world with objective criteria, efficiency and measurable results.",
+		Positions: [4]string{
+			"Decisive planning, clear structure and a focus on getting things done.",
+			"Turns the dominant function's insights into concrete plans and steps.",
+			"Bursts of organizing energy and a wish to be seen as competent.",
+			"Under stress, bossy attempts to control things and blunt judgments of incompetence.",
+		},
+	},
+	"Fi": {
+		Name:    "Introverted Feeling",
+		Summary: "Evaluates everything against a deeply held set of personal values and emotions.",
+		Positions: [4]string{
+			"A strong moral compass, authenticity and loyalty to what one believes in.",
+			"Gives the dominant function a sense of what is right and worth pursuing.",
+			"A growing awareness of one's own feelings and convictions, shared with few.",
+			"Under stress, outbursts of hurt feelings and a sense of being misunderstood.",
+		},
+	},
+	"Fe": {
+		Name:    "Extraverted Feeling",
+		Summary: "Reads and harmonizes the emotions and needs of the group, following shared values.",
+		Positions: [4]string{
+			"Warmth, tact and a drive to bring people together and look after them.",
+			"Lets the dominant function's ideas be communicated in a way that helps others.",
+			"A developing wish to be liked and to connect, sometimes shown awkwardly.",
+			"Under stress, emotional outbursts and oversensitivity to what others think.",
+		},
+	},
+}
@@ -0,0 +1,98 @@
+// Package api defines the JSON forms of the mbti library's types
+// shared by the HTTP server and the JavaScript bindings.
+package api
+
+import (
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+type Personality struct {
+	Indicator string   `json:"indicator"`
+	Functions []string `json:"functions"`
+}
+
+func NewPersonality(p *mbti.Personality) Personality {
+	return Personality{Indicator: p.String(), Functions: FunctionStrings(p.Functions())}
+}
+
+func FunctionStrings(functions []mbti.Function) []string {
+	ret := make([]string, 0, len(functions))
+	for _, fn := range functions {
+		ret = append(ret, fn.String())
+	}
+
+	return ret
+}
+
+type Mind struct {
+	Ego          Personality `json:"ego"`
+	Unconscious  Personality `json:"unconscious"`
+	Subconscious Personality `json:"subconscious"`
+	SuperEgo     Personality `json:"superEgo"`
+}
+
+func NewMind(m *mbti.Mind) Mind {
+	return Mind{
+		Ego:          NewPersonality(m.Ego),
+		Unconscious:  NewPersonality(m.Unconscious),
+		Subconscious: NewPersonality(m.Subconscious),
+		SuperEgo:     NewPersonality(m.SuperEgo),
+	}
+}
+
+type Comparison struct {
+	A               Personality `json:"a"`
+	B               Personality `json:"b"`
+	SharedFunctions []string    `json:"sharedFunctions"`
+	SamePosition    []string    `json:"samePosition"`
+}
+
+func NewComparison(c *mbti.Comparison) Comparison {
+	return Comparison{
+		A:               NewPersonality(c.A),
+		B:               NewPersonality(c.B),
+		SharedFunctions: FunctionStrings(c.SharedFunctions),
+		SamePosition:    FunctionStrings(c.SamePosition),
+	}
+}
+
+type QuizResult struct {
+	Indicator string        `json:"indicator"`
+	Scores    [4]quiz.Score `json:"scores"`
+	Mind      Mind          `json:"mind"`
+}
+
+func NewQuizResult(r *quiz.Result) (QuizResult, error) {
+	p, err := r.Personality()
+	if err != nil {
+		return QuizResult{}, err
+	}
+
+	return QuizResult{
+		Indicator: r.Indicator(),
+		Scores:    r.Scores,
+		Mind:      NewMind(mbti.NewMind(p)),
+	}, nil
+}
+
+type QuizSession struct {
+	ID       string `json:"id"`
+	Answered int    `json:"answered"`
+	Total    int    `json:"total"`
+	// Next is the question to answer next, absent once the quiz is complete.
+	Next *quiz.Question `json:"next,omitempty"`
+}
+
+func NewQuizSession(s *quiz.Session, questions []quiz.Question) QuizSession {
+	ret := QuizSession{ID: s.ID, Answered: len(s.Answers), Total: len(questions)}
+	if q, ok := s.Next(questions); ok {
+		ret.Next = &q
+	}
+
+	return ret
+}
+
+type Error struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,36 @@
+package mbti
+
+// levenshtein returns the edit distance between two strings. Transpositions
+// of adjacent characters count as a single edit, as they are common typos.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	prevprev := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] && prevprev[j-2]+1 < curr[j] {
+				curr[j] = prevprev[j-2] + 1
+			}
+		}
+
+		prevprev, prev, curr = prev, curr, prevprev
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,123 @@
+package mbti
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// Locale describes how personality types are written in a language, so that
+// localized input can be mapped to the English notation Parse understands.
+type Locale struct {
+	// Tag is the language tag of the locale, such as "de".
+	Tag string
+	// Letters maps localized dichotomy letters to the English ones, such as "Дж" to "J".
+	Letters map[string]string
+	// Nicknames maps localized nicknames to type indicators.
+	Nicknames map[string]string
+}
+
+var (
+	locales   = map[string]Locale{}
+	localesMu sync.RWMutex
+)
+
+func init() {
+	for _, l := range builtinLocales {
+		RegisterLocale(l)
+	}
+}
+
+// RegisterLocale adds a locale, replacing any locale with the same tag.
+func RegisterLocale(l Locale) {
+	localesMu.Lock()
+	defer localesMu.Unlock()
+
+	locales[strings.ToLower(l.Tag)] = l
+}
+
+// LookupLocale returns the locale of a language tag or POSIX locale name,
+// such as "de", "de-AT" or "de_DE.UTF-8", by its language.
+func LookupLocale(tag string) (Locale, bool) {
+	language := tag
+	if i := strings.IndexAny(tag, "-_.@"); i >= 0 {
+		language = tag[:i]
+	}
+
+	language = strings.ToLower(language)
+
+	localesMu.RLock()
+	defer localesMu.RUnlock()
+
+	l, ok := locales[language]
+
+	return l, ok
+}
+
+// Translate maps a localized nickname to its indicator and localized
+// dichotomy letters to English ones. Other text is kept as it is.
+func (l Locale) Translate(input string) string {
+	trimmed := strings.TrimSpace(input)
+
+	for nickname, indicator := range l.Nicknames {
+		if strings.EqualFold(trimmed, nickname) {
+			return indicator
+		}
+	}
+
+	// Match longer letters first, so "Дж" isn't read as "Д" followed by "ж".
+	letters := make([]string, 0, len(l.Letters))
+	for letter := range l.Letters {
+		letters = append(letters, letter)
+	}
+
+	sort.Slice(letters, func(i, j int) bool {
+		return len(letters[i]) > len(letters[j])
+	})
+
+	var b strings.Builder
+
+outer:
+	for rest := input; rest != ""; {
+		for _, letter := range letters {
+			if len(rest) >= len(letter) && strings.EqualFold(rest[:len(letter)], letter) {
+				b.WriteString(l.Letters[letter])
+				rest = rest[len(letter):]
+
+				continue outer
+			}
+		}
+
+		_, size := utf8.DecodeRuneInString(rest)
+		b.WriteString(rest[:size])
+		rest = rest[size:]
+	}
+
+	return b.String()
+}
+
+// ParseLocalized parses input written in the given locale or in English.
+// Function pairs may also be written in the alphabet registered under the
+// tag of the locale, as in "IiDe" for NiTe in German.
+func ParseLocalized(input string, l Locale) (*Personality, error) {
+	p, err := parse(input)
+	if err != nil {
+		p, err = parse(l.Translate(input))
+	}
+
+	if a, ok := LookupAlphabet(l.Tag); ok && err != nil {
+		if fns, fnErr := a.ParseFunctions(removeFunctionSeparators(input)); fnErr == nil && len(fns) == 2 {
+			p, err = FromDominantFunctions(fns[0], fns[1])
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidInput, input)
+	}
+
+	publishParsed(input, p)
+
+	return p, nil
+}
@@ -0,0 +1,46 @@
+package mbti
+
+var builtinLocales = []Locale{
+	{
+		Tag: "de",
+		Nicknames: map[string]string{
+			"Architekt": "INTJ", "Logiker": "INTP", "Kommandeur": "ENTJ", "Debattierer": "ENTP",
+			"Advokat": "INFJ", "Mediator": "INFP", "Protagonist": "ENFJ", "Aktivist": "ENFP",
+			"Logistiker": "ISTJ", "Verteidiger": "ISFJ", "Geschäftsführer": "ESTJ", "Konsul": "ESFJ",
+			"Virtuose": "ISTP", "Abenteurer": "ISFP", "Unternehmer": "ESTP", "Entertainer": "ESFP",
+		},
+	},
+	{
+		Tag: "es",
+		Nicknames: map[string]string{
+			"Arquitecto": "INTJ", "Lógico": "INTP", "Comandante": "ENTJ", "Innovador": "ENTP",
+			"Abogado": "INFJ", "Mediador": "INFP", "Protagonista": "ENFJ", "Activista": "ENFP",
+			"Logista": "ISTJ", "Defensor": "ISFJ", "Ejecutivo": "ESTJ", "Cónsul": "ESFJ",
+			"Virtuoso": "ISTP", "Aventurero": "ISFP", "Emprendedor": "ESTP", "Animador": "ESFP",
+		},
+	},
+	{
+		Tag: "fr",
+		Nicknames: map[string]string{
+			"Architecte": "INTJ", "Logicien": "INTP", "Commandant": "ENTJ", "Innovateur": "ENTP",
+			"Avocat": "INFJ", "Médiateur": "INFP", "Protagoniste": "ENFJ", "Inspirateur": "ENFP",
+			"Logisticien": "ISTJ", "Défenseur": "ISFJ", "Directeur": "ESTJ", "Consul": "ESFJ",
+			"Virtuose": "ISTP", "Aventurier": "ISFP", "Entrepreneur": "ESTP", "Amuseur": "ESFP",
+		},
+	},
+	{
+		Tag: "ru",
+		// Russian texts write indicators in Cyrillic, as in ИНТДж, and functions as in Ни/Те.
+		Letters: map[string]string{
+			"Э": "E", "И": "I", "С": "S", "Н": "N", "Т": "T", "Ф": "F", "Дж": "J", "П": "P",
+			// The focus of functions is written in lowercase; и is matched by И above.
+			"е": "e",
+		},
+		Nicknames: map[string]string{
+			"Стратег": "INTJ", "Учёный": "INTP", "Командир": "ENTJ", "Полемист": "ENTP",
+			"Активист": "INFJ", "Посредник": "INFP", "Тренер": "ENFJ", "Борец": "ENFP",
+			"Администратор": "ISTJ", "Защитник": "ISFJ", "Менеджер": "ESTJ", "Консул": "ESFJ",
+			"Виртуоз": "ISTP", "Артист": "ISFP", "Делец": "ESTP", "Развлекатель": "ESFP",
+		},
+	},
+}
@@ -0,0 +1,168 @@
+package mbti
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+type Metadata struct {
+	Nickname string `json:"nickname,omitempty"`
+	// Aliases are alternative nicknames, which are also matched by Search.
+	Aliases     []string `json:"aliases,omitempty"`
+	Group       string   `json:"group,omitempty"`
+	Description string   `json:"description,omitempty"`
+	// RelationNotes are notes about the relation with other types, keyed by their indicator.
+	RelationNotes map[string]string `json:"relationNotes,omitempty"`
+}
+
+// MetadataProvider supplies metadata for personality types, identified by their indicator.
+type MetadataProvider interface {
+	Metadata(indicator string) (Metadata, bool)
+}
+
+// MetadataProviderFunc adapts a function to a MetadataProvider, for metadata
+// that is computed or loaded on demand, such as translations.
+type MetadataProviderFunc func(indicator string) (Metadata, bool)
+
+func (f MetadataProviderFunc) Metadata(indicator string) (Metadata, bool) {
+	return f(indicator)
+}
+
+// MetadataMap is a MetadataProvider backed by a map keyed by indicator.
+type MetadataMap map[string]Metadata
+
+func (m MetadataMap) Metadata(indicator string) (Metadata, bool) {
+	md, ok := m[indicator]
+
+	return md, ok
+}
+
+const (
+	groupAnalysts  = "Analysts"
+	groupDiplomats = "Diplomats"
+	groupSentinels = "Sentinels"
+	groupExplorers = "Explorers"
+)
+
+var builtinMetadata = MetadataMap{
+	"INTJ": {Nickname: "Architect", Group: groupAnalysts, Description: "Strategic and independent thinkers who build long-term plans and improve systems with imagination and rigor."},
+	"INTP": {Nickname: "Logician", Group: groupAnalysts, Description: "Curious analysts fascinated by ideas, who take theories apart to understand how everything works."},
+	"ENTJ": {Nickname: "Commander", Group: groupAnalysts, Description: "Decisive, driven leaders who organize people and resources to reach ambitious goals efficiently."},
+	"ENTP": {Nickname: "Debater", Group: groupAnalysts, Description: "Quick-witted inventors who love intellectual challenges, brainstorming and arguing every side of a question."},
+	"INFJ": {Nickname: "Advocate", Group: groupDiplomats, Description: "Insightful idealists with a quiet vision for helping others and a deep sense of purpose."},
+	"INFP": {Nickname: "Mediator", Group: groupDiplomats, Description: "Gentle, imaginative idealists guided by personal values, seeking harmony and authenticity."},
+	"ENFJ": {Nickname: "Protagonist", Group: groupDiplomats, Description: "Charismatic mentors who inspire and organize people around shared values and growth."},
+	"ENFP": {Nickname: "Campaigner", Group: groupDiplomats, Description: "Enthusiastic, creative free spirits who see possibilities everywhere and connect with people easily."},
+	"ISTJ": {Nickname: "Logistician", Group: groupSentinels, Description: "Dependable, thorough organizers who honor commitments, facts and proven procedures."},
+	"ISFJ": {Nickname: "Defender", Group: groupSentinels, Description: "Warm, devoted protectors who remember details about people and quietly take care of them."},
+	"ESTJ": {Nickname: "Executive", Group: groupSentinels, Description: "Practical administrators who bring order, structure and clear rules to groups and projects."},
+	"ESFJ": {Nickname: "Consul", Group: groupSentinels, Description: "Caring, sociable hosts who keep communities together and make sure everyone is looked after."},
+	"ISTP": {Nickname: "Virtuoso", Group: groupExplorers, Description: "Cool-headed, hands-on troubleshooters who master tools and react skillfully in the moment."},
+	"ISFP": {Nickname: "Adventurer", Group: groupExplorers, Description: "Sensitive, artistic explorers who live in the present and express themselves through experience."},
+	"ESTP": {Nickname: "Entrepreneur", Group: groupExplorers, Description: "Energetic, pragmatic risk-takers who learn by doing and thrive on action and negotiation."},
+	"ESFP": {Nickname: "Entertainer", Group: groupExplorers, Description: "Spontaneous, playful performers who bring energy and fun to every room they enter."},
+}
+
+var (
+	metadataProviders   = []MetadataProvider{builtinMetadata}
+	metadataProvidersMu sync.RWMutex
+)
+
+// RegisterMetadataProvider adds an overlay over the built-in metadata. The
+// non-empty fields of the metadata supplied by later providers take precedence;
+// aliases and relation notes are merged.
+func RegisterMetadataProvider(p MetadataProvider) {
+	metadataProvidersMu.Lock()
+	defer metadataProvidersMu.Unlock()
+
+	metadataProviders = append(metadataProviders, p)
+}
+
+// SetMetadataProviders replaces all the providers, including the built-in
+// metadata, which BuiltinMetadata returns. The providers are overlaid in order,
+// as if registered with RegisterMetadataProvider.
+func SetMetadataProviders(providers ...MetadataProvider) {
+	metadataProvidersMu.Lock()
+	defer metadataProvidersMu.Unlock()
+
+	metadataProviders = append([]MetadataProvider(nil), providers...)
+}
+
+// BuiltinMetadata returns the provider of the built-in English metadata.
+func BuiltinMetadata() MetadataProvider {
+	return builtinMetadata
+}
+
+func mergeMetadata(base, overlay Metadata) Metadata {
+	if overlay.Nickname != "" {
+		base.Nickname = overlay.Nickname
+	}
+
+	if overlay.Group != "" {
+		base.Group = overlay.Group
+	}
+
+	if overlay.Description != "" {
+		base.Description = overlay.Description
+	}
+
+	base.Aliases = append(append([]string(nil), base.Aliases...), overlay.Aliases...)
+
+	if len(overlay.RelationNotes) > 0 {
+		notes := make(map[string]string, len(base.RelationNotes)+len(overlay.RelationNotes))
+		for k, v := range base.RelationNotes {
+			notes[k] = v
+		}
+
+		for k, v := range overlay.RelationNotes {
+			notes[strings.ToUpper(k)] = v
+		}
+
+		base.RelationNotes = notes
+	}
+
+	return base
+}
+
+func metadataOf(indicator string) Metadata {
+	metadataProvidersMu.RLock()
+	defer metadataProvidersMu.RUnlock()
+
+	var ret Metadata
+
+	for _, p := range metadataProviders {
+		if md, ok := p.Metadata(indicator); ok {
+			ret = mergeMetadata(ret, md)
+		}
+	}
+
+	return ret
+}
+
+// Metadata returns the metadata of the personality, merged from all registered providers.
+func (p *Personality) Metadata() Metadata {
+	return metadataOf(p.Indicator())
+}
+
+// Nickname returns the nickname of the personality, such as Architect for INTJ.
+func (p *Personality) Nickname() string {
+	return p.Metadata().Nickname
+}
+
+// Description returns a short description of the personality.
+func (p *Personality) Description() string {
+	return p.Metadata().Description
+}
+
+// indicators returns the indicators of all 16 personality types, sorted.
+func indicators() []string {
+	ret := make([]string, 0, len(builtinMetadata))
+	for indicator := range builtinMetadata {
+		ret = append(ret, indicator)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
@@ -0,0 +1,17 @@
+package mbti
+
+type Mind struct {
+	Ego          *Personality
+	Unconscious  *Personality
+	Subconscious *Personality
+	SuperEgo     *Personality
+}
+
+func NewMind(ego *Personality) *Mind {
+	return &Mind{
+		Ego:          ego,
+		Unconscious:  ego.Unconscious(),
+		Subconscious: ego.Subconscious(),
+		SuperEgo:     ego.SuperEgo(),
+	}
+}
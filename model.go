@@ -0,0 +1,169 @@
+package mbti
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Model is a school of typology's way of deriving the function stack and the
+// other sides of the mind of a type from its dominant and auxiliary functions.
+// Communities disagree mostly on the attitude of the tertiary function.
+type Model interface {
+	// Name is the name of the model, in lowercase.
+	Name() string
+	// Stack returns the four functions of the type with the given dominant
+	// and auxiliary functions, which are valid, in order.
+	Stack(dominant, auxiliary Function) [4]Function
+	Unconscious(p *Personality) *Personality
+	Subconscious(p *Personality) *Personality
+	SuperEgo(p *Personality) *Personality
+}
+
+var (
+	// ModelGrant is the Grant/Brownsword model, used by default: the tertiary
+	// function has the attitude of the dominant one, as in Ni Te Fi Se.
+	ModelGrant Model = grantModel{}
+	// ModelMyers is the classic Myers model: the tertiary function has the
+	// attitude of the auxiliary one, as in Ni Te Fe Se.
+	ModelMyers Model = myersModel{}
+	// ModelA is the socionics Model A, whose four leading functions are the
+	// ego block followed by the super-ego block, as in Ni Te Si Fe. The
+	// other sides of the mind are the remaining blocks.
+	ModelA Model = modelA{}
+)
+
+// Models lists the built-in models.
+var Models = [...]Model{ModelGrant, ModelMyers, ModelA}
+
+var ErrUnknownModel = errors.New("unknown model")
+
+// LookupModel returns the built-in model with the given name, ignoring case.
+func LookupModel(name string) (Model, error) {
+	for _, m := range Models {
+		if strings.EqualFold(m.Name(), name) {
+			return m, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrUnknownModel, name)
+}
+
+// withModel creates the personality with the given dominant and auxiliary functions
+// in the model. The functions must be able to form a type.
+func withModel(dominant, auxiliary Function, m Model) *Personality {
+	stack := m.Stack(dominant, auxiliary)
+
+	return &Personality{primary: stack[0], auxiliary: stack[1], tertiary: stack[2], inferior: stack[3], model: m}
+}
+
+// unconsciousOf returns the type using the same functions in the opposite
+// attitudes, which is the unconscious in both the Grant and Myers models.
+func unconsciousOf(p *Personality, m Model) *Personality {
+	return withModel(p.primary.invertFocus(), p.auxiliary.invertFocus(), m)
+}
+
+// subconsciousOf returns the opposite type, which is the subconscious in both
+// the Grant and Myers models.
+func subconsciousOf(p *Personality, m Model) *Personality {
+	return withModel(p.primary.invert(), p.auxiliary.invert(), m)
+}
+
+type grantModel struct{}
+
+func (grantModel) Name() string { return "grant" }
+
+func (grantModel) Stack(dominant, auxiliary Function) [4]Function {
+	return [4]Function{
+		dominant,
+		auxiliary,
+		{focus: dominant.focus, kind: invertKind(auxiliary.kind)},
+		{focus: auxiliary.focus, kind: invertKind(dominant.kind)},
+	}
+}
+
+func (m grantModel) Unconscious(p *Personality) *Personality  { return unconsciousOf(p, m) }
+func (m grantModel) Subconscious(p *Personality) *Personality { return subconsciousOf(p, m) }
+
+func (m grantModel) SuperEgo(p *Personality) *Personality {
+	return m.Unconscious(m.Subconscious(p))
+}
+
+type myersModel struct{}
+
+func (myersModel) Name() string { return "myers" }
+
+func (myersModel) Stack(dominant, auxiliary Function) [4]Function {
+	return [4]Function{
+		dominant,
+		auxiliary,
+		{focus: auxiliary.focus, kind: invertKind(auxiliary.kind)},
+		{focus: auxiliary.focus, kind: invertKind(dominant.kind)},
+	}
+}
+
+func (m myersModel) Unconscious(p *Personality) *Personality  { return unconsciousOf(p, m) }
+func (m myersModel) Subconscious(p *Personality) *Personality { return subconsciousOf(p, m) }
+
+func (m myersModel) SuperEgo(p *Personality) *Personality {
+	return m.Unconscious(m.Subconscious(p))
+}
+
+type modelA struct{}
+
+func (modelA) Name() string { return "model-a" }
+
+func (modelA) Stack(dominant, auxiliary Function) [4]Function {
+	return [4]Function{dominant, auxiliary, dominant.invertKind(), auxiliary.invertKind()}
+}
+
+// Unconscious returns the type led by the id block: the ignoring and demonstrative functions.
+func (m modelA) Unconscious(p *Personality) *Personality {
+	return withModel(p.primary.invertFocus(), p.auxiliary.invertFocus(), m)
+}
+
+// Subconscious returns the type led by the super-id block: the suggestive and mobilizing functions.
+func (m modelA) Subconscious(p *Personality) *Personality {
+	return withModel(p.primary.invert(), p.auxiliary.invert(), m)
+}
+
+// SuperEgo returns the type led by the super-ego block: the role and vulnerable functions.
+func (m modelA) SuperEgo(p *Personality) *Personality {
+	return withModel(p.tertiary, p.inferior, m)
+}
+
+// FromDominantFunctionsWithModel is FromDominantFunctions using the given model.
+func FromDominantFunctionsWithModel(primary, auxiliary Function, m Model) (*Personality, error) {
+	if err := validateDominantFunctions(primary, auxiliary); err != nil {
+		return nil, err
+	}
+
+	return withModel(primary, auxiliary, m), nil
+}
+
+// FromIndicatorWithModel is FromIndicator using the given model.
+func FromIndicatorWithModel(indicator string, m Model) (*Personality, error) {
+	p, err := FromIndicator(indicator)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.WithModel(m), nil
+}
+
+// Model returns the model the personality was derived with.
+func (p *Personality) Model() Model {
+	if p.model == nil {
+		return ModelGrant
+	}
+
+	return p.model
+}
+
+// WithModel returns the personality derived with the given model, keeping its identity.
+func (p *Personality) WithModel(m Model) *Personality {
+	c := withModel(p.primary, p.auxiliary, m)
+	c.identity = p.identity
+
+	return c
+}
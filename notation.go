@@ -0,0 +1,207 @@
+package mbti
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Notation is a way of writing personality types, used by a typology community.
+type Notation interface {
+	// Name is the name the notation is registered under, in lowercase.
+	Name() string
+	Format(p *Personality) string
+	Parse(s string) (*Personality, error)
+}
+
+var (
+	ErrUnknownNotation = errors.New("unknown notation")
+	ErrInvalidNotation = errors.New("invalid type notation")
+)
+
+var (
+	notations = map[string]Notation{
+		"mbti":      mbtiNotation{},
+		"functions": functionsNotation{},
+		"socionics": socionicsNotation{},
+		"ops":       opsNotation{},
+	}
+	notationsMu sync.RWMutex
+)
+
+// RegisterNotation adds a notation to the ones Convert and LookupNotation
+// know about, replacing any notation with the same name.
+func RegisterNotation(n Notation) {
+	notationsMu.Lock()
+	defer notationsMu.Unlock()
+
+	notations[strings.ToLower(n.Name())] = n
+}
+
+// LookupNotation returns the notation registered under the given name, ignoring case.
+func LookupNotation(name string) (Notation, error) {
+	notationsMu.RLock()
+	defer notationsMu.RUnlock()
+
+	n, ok := notations[strings.ToLower(name)]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownNotation, name)
+	}
+
+	return n, nil
+}
+
+// NotationNames returns the names of all registered notations, sorted.
+func NotationNames() []string {
+	notationsMu.RLock()
+	defer notationsMu.RUnlock()
+
+	ret := make([]string, 0, len(notations))
+	for name := range notations {
+		ret = append(ret, name)
+	}
+
+	sort.Strings(ret)
+
+	return ret
+}
+
+// Convert rewrites a type written in one notation in another one.
+func Convert(s, from, to string) (string, error) {
+	src, err := LookupNotation(from)
+	if err != nil {
+		return "", err
+	}
+
+	dst, err := LookupNotation(to)
+	if err != nil {
+		return "", err
+	}
+
+	p, err := src.Parse(s)
+	if err != nil {
+		return "", err
+	}
+
+	return dst.Format(p), nil
+}
+
+func invalidNotation(s, notation string) error {
+	return fmt.Errorf("%w: %q is not a %s type", ErrInvalidNotation, s, notation)
+}
+
+// mbtiNotation is the Myers-Briggs type indicator, such as INTJ.
+type mbtiNotation struct{}
+
+func (mbtiNotation) Name() string { return "mbti" }
+
+func (mbtiNotation) Format(p *Personality) string { return p.String() }
+
+func (mbtiNotation) Parse(s string) (*Personality, error) {
+	indicator := strings.ToUpper(removeSpaces(s))
+	if !IsIndicatorString(indicator) {
+		return nil, invalidNotation(s, "mbti")
+	}
+
+	return FromIndicator(indicator)
+}
+
+// functionsNotation is the pair of dominant functions, such as NiTe.
+type functionsNotation struct{}
+
+func (functionsNotation) Name() string { return "functions" }
+
+func (functionsNotation) Format(p *Personality) string {
+	return p.primary.String() + p.auxiliary.String()
+}
+
+func (functionsNotation) Parse(s string) (*Personality, error) {
+	p, err := parse(s)
+	if err != nil || IsIndicatorString(strings.ToUpper(removeSpaces(s))) {
+		return nil, invalidNotation(s, "functions")
+	}
+
+	return p, nil
+}
+
+// socionicsCodes maps dominant function pairs to socionics three-letter codes.
+// Socionics uses the same functions as Myers-Briggs, so ENTP (Ne Ti) is ILE.
+var socionicsCodes = map[string]string{
+	"NeTi": "ILE", "SiFe": "SEI", "FeSi": "ESE", "TiNe": "LII",
+	"SeTi": "SLE", "NiFe": "IEI", "FeNi": "EIE", "TiSe": "LSI",
+	"SeFi": "SEE", "NiTe": "ILI", "TeNi": "LIE", "FiSe": "ESI",
+	"NeFi": "IEE", "SiTe": "SLI", "TeSi": "LSE", "FiNe": "EII",
+}
+
+// socionicsNotation is the socionics three-letter code, such as ILE. Parse
+// also accepts the four-letter form with a lowercase last letter, such as
+// ENTp, whose rationality letter differs from Myers-Briggs for introverts.
+type socionicsNotation struct{}
+
+func (socionicsNotation) Name() string { return "socionics" }
+
+func (socionicsNotation) Format(p *Personality) string {
+	return p.Socionics()
+}
+
+func (socionicsNotation) Parse(s string) (*Personality, error) {
+	code := removeSpaces(s)
+
+	for pair, c := range socionicsCodes {
+		fns, _ := FunctionsFromString(pair)
+		p, _ := FromDominantFunctions(fns[0], fns[1])
+
+		if strings.EqualFold(code, c) || code == socionicsFourLetter(p) {
+			return p, nil
+		}
+	}
+
+	return nil, invalidNotation(s, "socionics")
+}
+
+// socionicsFourLetter returns the four-letter socionics code of the personality,
+// whose last letter tells whether the dominant function is rational (j) or irrational (p).
+func socionicsFourLetter(p *Personality) string {
+	indicator := []rune(p.Indicator())
+	indicator[3] = 'p'
+
+	if p.primary.IsJudging() {
+		indicator[3] = 'j'
+	}
+
+	return string(indicator)
+}
+
+// opsNotation is the pair of savior functions of the Objective Personality
+// System, such as Ni/Te. Parse ignores the other parts of a full OPS type,
+// as in "MF-Ni/Te CP/B(S) #2".
+type opsNotation struct{}
+
+func (opsNotation) Name() string { return "ops" }
+
+func (opsNotation) Format(p *Personality) string {
+	return p.primary.String() + "/" + p.auxiliary.String()
+}
+
+func (opsNotation) Parse(s string) (*Personality, error) {
+	for _, token := range strings.FieldsFunc(s, func(r rune) bool { return unicode.IsSpace(r) || r == '-' }) {
+		parts := strings.Split(token, "/")
+		if len(parts) != 2 || FunctionCountInString(parts[0]) != 1 || FunctionCountInString(parts[1]) != 1 {
+			continue
+		}
+
+		fns, _ := FunctionsFromString(parts[0] + parts[1])
+
+		p, err := FromDominantFunctions(fns[0], fns[1])
+		if err != nil {
+			return nil, invalidNotation(s, "ops")
+		}
+
+		return p, nil
+	}
+
+	return nil, invalidNotation(s, "ops")
+}
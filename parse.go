@@ -0,0 +1,108 @@
+package mbti
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidInput = errors.New("input is neither a type indicator nor a pair of dominant functions")
+
+// functionSeparators may appear between the functions of a pair, as in "Ni/Fe" or "Ni-Fe".
+const functionSeparators = "/-,_"
+
+func removeSpaces(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+
+		return r
+	}, s)
+}
+
+// Parse creates a personality from either a type indicator (INTJ) or
+// a pair of dominant functions (NiTe). It is lenient: letter case and
+// whitespace are ignored, function pairs may be separated ("Ni Fe",
+// "Ni/Fe") and indicators may have an identity suffix ("intj -a").
+// Successful parses publish EventTypeParsed.
+func Parse(input string) (*Personality, error) {
+	p, err := parse(input)
+	if err == nil {
+		publishParsed(input, p)
+	}
+
+	return p, err
+}
+
+func publishParsed(input string, p *Personality) {
+	Publish(Event{Name: EventTypeParsed, Payload: TypeParsed{Input: input, Personality: p}})
+}
+
+// removeFunctionSeparators removes the whitespace and separators between functions.
+func removeFunctionSeparators(s string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(functionSeparators, r) {
+			return -1
+		}
+
+		return r
+	}, removeSpaces(s))
+}
+
+func parse(input string) (*Personality, error) {
+	compact := removeSpaces(input)
+	functions := removeFunctionSeparators(compact)
+
+	if FunctionCountInString(functions) == 2 {
+		fns, _ := FunctionsFromString(functions)
+
+		return FromDominantFunctions(fns[0], fns[1])
+	}
+
+	if indicator := strings.ToUpper(compact); IsIndicatorString(indicator) {
+		return FromIndicator(indicator)
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrInvalidInput, input)
+}
+
+func isSingleFunction(token string) bool {
+	return FunctionCountInString(strings.Trim(token, functionSeparators)) == 1
+}
+
+func isIdentitySuffix(token string) bool {
+	switch strings.ToUpper(token) {
+	case "-A", "-T":
+		return true
+	default:
+		return false
+	}
+}
+
+// Fields splits a line into the inputs it contains, so that each can be
+// given to Parse. Inputs are separated by whitespace, except for function
+// pairs written with a space ("Ni Fe") and identity suffixes ("INTJ -A"),
+// which are kept together.
+func Fields(line string) []string {
+	tokens := strings.Fields(line)
+	fields := make([]string, 0, len(tokens))
+
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+
+		switch {
+		case isSingleFunction(token) && i+1 < len(tokens) && isSingleFunction(tokens[i+1]):
+			token += " " + tokens[i+1]
+			i++
+		case i+1 < len(tokens) && isIdentitySuffix(tokens[i+1]):
+			token += " " + tokens[i+1]
+			i++
+		}
+
+		fields = append(fields, token)
+	}
+
+	return fields
+}
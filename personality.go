@@ -12,51 +12,105 @@ type Personality struct {
 	auxiliary Function
 	tertiary  Function
 	inferior  Function
+	identity  Identity
+	// model is the model the stack was derived with, ModelGrant if nil.
+	model Model
 }
 
-func (p *Personality) Unconscious() *Personality {
-	return &Personality{
-		primary:   p.primary.invertFocus(),
-		auxiliary: p.auxiliary.invertFocus(),
-		tertiary:  p.tertiary.invertFocus(),
-		inferior:  p.inferior.invertFocus(),
+// Identity is the Assertive or Turbulent variant of a type, written as an
+// "-A" or "-T" suffix of the indicator by the 16Personalities model. It
+// doesn't change the functions of the type.
+type Identity int
+
+const (
+	IdentityUnknown Identity = iota
+	IdentityAssertive
+	IdentityTurbulent
+)
+
+var identityNames = [...]string{
+	IdentityUnknown:   "Unknown",
+	IdentityAssertive: "Assertive",
+	IdentityTurbulent: "Turbulent",
+}
+
+func (i Identity) String() string {
+	if i < IdentityUnknown || i > IdentityTurbulent {
+		return fmt.Sprintf("Identity(%d)", int(i))
 	}
+
+	return identityNames[i]
 }
 
-func (p *Personality) Subconscious() *Personality {
-	return &Personality{
-		primary:   p.inferior,
-		auxiliary: p.tertiary,
-		tertiary:  p.auxiliary,
-		inferior:  p.primary,
+// Suffix returns the indicator suffix of the identity, which is empty if the identity is unknown.
+func (i Identity) Suffix() string {
+	switch i {
+	case IdentityAssertive:
+		return "-A"
+	case IdentityTurbulent:
+		return "-T"
+	default:
+		return ""
 	}
 }
 
+// Identity returns the identity of the personality, which is only known
+// if it was created from an indicator with an identity suffix.
+func (p *Personality) Identity() Identity {
+	return p.identity
+}
+
+// WithIdentity returns a copy of the personality with the given identity.
+func (p *Personality) WithIdentity(i Identity) *Personality {
+	c := *p
+	c.identity = i
+
+	return &c
+}
+
+// Unconscious, Subconscious and SuperEgo return the other sides of the mind,
+// as defined by the model of the personality.
+
+func (p *Personality) Unconscious() *Personality {
+	return p.Model().Unconscious(p)
+}
+
+func (p *Personality) Subconscious() *Personality {
+	return p.Model().Subconscious(p)
+}
+
 func (p *Personality) SuperEgo() *Personality {
-	return p.Subconscious().Unconscious()
+	return p.Model().SuperEgo(p)
 }
 
+// String returns the indicator of the personality, followed by the suffix
+// of its identity if it is known: INTJ or INTJ-T.
 func (p *Personality) String() string {
+	return p.Indicator() + p.identity.Suffix()
+}
+
+// Indicator returns the four-letter indicator of the personality, without identity.
+func (p *Personality) Indicator() string {
 	ret := &strings.Builder{}
 
 	var extrovertedFunction Function
 
 	if p.primary.IsExtroverted() {
-		ret.WriteRune(focusExternal)
+		ret.WriteRune(rune(FocusExternal))
 
 		extrovertedFunction = p.primary
 	} else {
-		ret.WriteRune(focusInternal)
+		ret.WriteRune(rune(FocusInternal))
 
 		extrovertedFunction = p.auxiliary
 	}
 
 	if p.primary.IsProspecting() {
-		ret.WriteRune(p.primary.kind)
-		ret.WriteRune(p.auxiliary.kind)
+		ret.WriteRune(rune(p.primary.kind))
+		ret.WriteRune(rune(p.auxiliary.kind))
 	} else {
-		ret.WriteRune(p.auxiliary.kind)
-		ret.WriteRune(p.primary.kind)
+		ret.WriteRune(rune(p.auxiliary.kind))
+		ret.WriteRune(rune(p.primary.kind))
 	}
 
 	if extrovertedFunction.IsProspecting() {
@@ -74,34 +128,38 @@ func (p *Personality) Functions() []Function {
 
 var ErrInvalidFunctions = errors.New("invalid functions")
 
+// FromDominantFunctions creates a personality from its dominant and auxiliary
+// functions, deriving the rest of its stack with ModelGrant.
 func FromDominantFunctions(primary, auxiliary Function) (*Personality, error) {
+	return FromDominantFunctionsWithModel(primary, auxiliary, ModelGrant)
+}
+
+func validateDominantFunctions(primary, auxiliary Function) error {
 	if !isValidFunction(primary) || !isValidFunction(auxiliary) {
-		return nil, ErrInvalidFunctions
+		return ErrInvalidFunctions
 	}
 
 	if primary.focus == auxiliary.focus || primary.IsJudging() == auxiliary.IsJudging() {
-		return nil, fmt.Errorf("%w: primary %q and auxiliary %q can't form a personality type", ErrInvalidFunctions, primary.String(), auxiliary.String())
+		return fmt.Errorf("%w: primary %q and auxiliary %q can't form a personality type", ErrInvalidFunctions, primary.String(), auxiliary.String())
 	}
 
-	return &Personality{
-		primary:   primary,
-		auxiliary: auxiliary,
-		tertiary: Function{
-			focus: primary.focus,
-			kind:  invertKind(auxiliary.kind),
-		},
-		inferior: Function{
-			focus: auxiliary.focus,
-			kind:  invertKind(primary.kind),
-		},
-	}, nil
+	return nil
 }
 
 var ErrInvalidIndicatorString = errors.New("invalid indicator string")
 
-func getIndicatorRunes(indicator string) (focusRune rune, perceivingRune rune, judgingRune rune, tacticsRune rune, err error) {
-	if strings.HasSuffix(indicator, "-A") || strings.HasSuffix(indicator, "-T") {
-		indicator = indicator[:len(indicator)-2]
+func getIndicatorRunes(indicator string) (focusRune rune, perceivingRune rune, judgingRune rune, tacticsRune rune, identity Identity, err error) {
+	if n := len(indicator); n > 2 {
+		switch strings.ToUpper(indicator[n-2:]) {
+		case "-A":
+			identity = IdentityAssertive
+		case "-T":
+			identity = IdentityTurbulent
+		}
+
+		if identity != IdentityUnknown {
+			indicator = indicator[:n-2]
+		}
 	}
 
 	if len(indicator) != 4 {
@@ -117,22 +175,24 @@ func getIndicatorRunes(indicator string) (focusRune rune, perceivingRune rune, j
 }
 
 func IsIndicatorString(indicator string) bool {
-	focusRune, perceivingRune, judgingRune, tacticsRune, err := getIndicatorRunes(indicator)
+	focusRune, perceivingRune, judgingRune, tacticsRune, _, err := getIndicatorRunes(indicator)
 
 	return err == nil &&
-		(focusRune == focusInternal || focusRune == focusExternal) &&
-		(perceivingRune == KindIntuition || perceivingRune == KindSensation) &&
-		(judgingRune == KindThinking || judgingRune == KindFeeling) &&
+		(Focus(focusRune) == FocusInternal || Focus(focusRune) == FocusExternal) &&
+		(Kind(perceivingRune) == KindIntuition || Kind(perceivingRune) == KindSensation) &&
+		(Kind(judgingRune) == KindThinking || Kind(judgingRune) == KindFeeling) &&
 		(tacticsRune == tacticJudging || tacticsRune == tacticProspecting)
 }
 
+// FromIndicator creates a personality from a type indicator, which may have
+// an identity suffix: INTJ, INTJ-A or INTJ-T.
 func FromIndicator(indicator string) (*Personality, error) {
-	focusRune, perceivingRune, judgingRune, tacticsRune, err := getIndicatorRunes(indicator)
+	focusRune, perceivingRune, judgingRune, tacticsRune, identity, err := getIndicatorRunes(indicator)
 	if err != nil {
 		return nil, err
 	}
 
-	primary, auxiliary := Function{focus: focusRune}, Function{}
+	primary, auxiliary := Function{focus: Focus(focusRune)}, Function{}
 
 	if primary.IsIntroverted() == primary.IsExtroverted() {
 		return nil, fmt.Errorf("%w: %q is not a valid focus letter", ErrInvalidIndicatorString, string(focusRune))
@@ -142,21 +202,68 @@ func FromIndicator(indicator string) (*Personality, error) {
 
 	if tacticsRune == tacticJudging {
 		if primary.IsExtroverted() {
-			primary.kind = judgingRune
-			auxiliary.kind = perceivingRune
+			primary.kind = Kind(judgingRune)
+			auxiliary.kind = Kind(perceivingRune)
 		} else {
-			auxiliary.kind = judgingRune
-			primary.kind = perceivingRune
+			auxiliary.kind = Kind(judgingRune)
+			primary.kind = Kind(perceivingRune)
 		}
 	} else if tacticsRune == tacticProspecting {
 		if primary.IsExtroverted() {
-			primary.kind = perceivingRune
-			auxiliary.kind = judgingRune
+			primary.kind = Kind(perceivingRune)
+			auxiliary.kind = Kind(judgingRune)
 		} else {
-			auxiliary.kind = perceivingRune
-			primary.kind = judgingRune
+			auxiliary.kind = Kind(perceivingRune)
+			primary.kind = Kind(judgingRune)
 		}
 	}
 
-	return FromDominantFunctions(primary, auxiliary)
+	p, err := FromDominantFunctions(primary, auxiliary)
+	if err != nil {
+		return nil, err
+	}
+
+	p.identity = identity
+
+	return p, nil
+}
+
+// wildcards stand for unknown letters in partial indicators.
+const wildcards = "X?"
+
+// indicatorLetters are the letters allowed in each position of an indicator.
+var indicatorLetters = [4][2]rune{
+	{rune(FocusExternal), rune(FocusInternal)},
+	{rune(KindSensation), rune(KindIntuition)},
+	{rune(KindThinking), rune(KindFeeling)},
+	{tacticJudging, tacticProspecting},
+}
+
+// FromPartialIndicator returns the personalities matching an indicator whose
+// unknown letters are written as X or ?, such as "XNTP" or "IN??", ordered as
+// by All. An identity suffix applies to all of them: "INTX-T".
+func FromPartialIndicator(indicator string) ([]*Personality, error) {
+	focusRune, perceivingRune, judgingRune, tacticsRune, identity, err := getIndicatorRunes(indicator)
+	if err != nil {
+		return nil, err
+	}
+
+	var predicates []Predicate
+
+	for i, letter := range [4]rune{focusRune, perceivingRune, judgingRune, tacticsRune} {
+		switch {
+		case strings.ContainsRune(wildcards, letter):
+		case letter == indicatorLetters[i][0] || letter == indicatorLetters[i][1]:
+			predicates = append(predicates, WithLetter(letter))
+		default:
+			return nil, fmt.Errorf("%w: %q is not a valid letter in position %d of %q", ErrInvalidIndicatorString, string(letter), i+1, indicator)
+		}
+	}
+
+	matches := Filter(predicates...)
+	for i, p := range matches {
+		matches[i] = p.WithIdentity(identity)
+	}
+
+	return matches, nil
 }
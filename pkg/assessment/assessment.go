@@ -0,0 +1,96 @@
+/*
+Package assessment is the former location of the questionnaire.
+
+Deprecated: import github.com/tmaxmax/mbti/quiz instead. This package only
+forwards to it and will be removed in the next major version.
+*/
+package assessment
+
+import (
+	"io"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+type (
+	Dichotomy        = quiz.Dichotomy
+	Question         = quiz.Question
+	Answer           = quiz.Answer
+	Score            = quiz.Score
+	Result           = quiz.Result
+	QuizAnswered     = quiz.QuizAnswered
+	Change           = quiz.Change
+	Problem          = quiz.Problem
+	FunctionQuestion = quiz.FunctionQuestion
+	Candidate        = quiz.Candidate
+	MistypeEstimate  = quiz.MistypeEstimate
+	Session          = quiz.Session
+	SessionStore     = quiz.SessionStore
+	MemoryStore      = quiz.MemoryStore
+	FileStore        = quiz.FileStore
+	RedisClient      = quiz.RedisClient
+	RedisStore       = quiz.RedisStore
+)
+
+const (
+	Attitude          = quiz.Attitude
+	Perception        = quiz.Perception
+	Judgement         = quiz.Judgement
+	Lifestyle         = quiz.Lifestyle
+	EventQuizAnswered = quiz.EventQuizAnswered
+)
+
+var (
+	Dichotomies      = quiz.Dichotomies
+	DefaultQuestions = quiz.DefaultQuestions
+
+	ErrUnknownDichotomy  = quiz.ErrUnknownDichotomy
+	ErrUnknownQuestion   = quiz.ErrUnknownQuestion
+	ErrInvalidChoice     = quiz.ErrInvalidChoice
+	ErrDuplicateAnswer   = quiz.ErrDuplicateAnswer
+	ErrSessionNotFound   = quiz.ErrSessionNotFound
+	ErrSessionComplete   = quiz.ErrSessionComplete
+	ErrUnexpectedAnswer  = quiz.ErrUnexpectedAnswer
+	ErrSessionIncomplete = quiz.ErrSessionIncomplete
+)
+
+func Evaluate(questions []Question, answers []Answer) (*Result, error) {
+	return quiz.Evaluate(questions, answers)
+}
+
+func Diff(before, after *Result) [4]Change {
+	return quiz.Diff(before, after)
+}
+
+func ParseDichotomy(s string) (Dichotomy, error) {
+	return quiz.ParseDichotomy(s)
+}
+
+func LoadQuestions(r io.Reader) ([]Question, error) {
+	return quiz.LoadQuestions(r)
+}
+
+func Validate(questions []Question) []Problem {
+	return quiz.Validate(questions)
+}
+
+func MistypeQuestions(claimed *mbti.Personality) []FunctionQuestion {
+	return quiz.MistypeQuestions(claimed)
+}
+
+func EstimateMistype(claimed *mbti.Personality, questions []FunctionQuestion, answers []Answer) (*MistypeEstimate, error) {
+	return quiz.EstimateMistype(claimed, questions, answers)
+}
+
+func NewSession() (*Session, error) {
+	return quiz.NewSession()
+}
+
+func NewMemoryStore() *MemoryStore {
+	return quiz.NewMemoryStore()
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	return quiz.NewFileStore(dir)
+}
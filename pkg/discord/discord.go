@@ -0,0 +1,195 @@
+/*
+Package discord provides ready-made handlers for Discord bots that want
+to offer an "/mbti" slash command.
+
+Bots receiving interactions over HTTP can mount the handler directly:
+
+	h, err := discord.NewHandler(publicKey)
+	if err != nil {
+		// the key is mistyped...
+	}
+
+	http.Handle("/interactions", h)
+
+Bots connected through a gateway library can instead pass the received
+interaction data to Respond and send back the returned response, or use
+NewEmbed to build the message themselves.
+
+The package mirrors only the parts of the Discord API it needs, so it
+doesn't depend on any Discord client library.
+*/
+package discord
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// CommandName is the name of the slash command handled by this package.
+const CommandName = "mbti"
+
+const optionType = "type"
+
+// Discord API constants used by this package.
+const (
+	InteractionTypePing               = 1
+	InteractionTypeApplicationCommand = 2
+
+	ResponseTypePong                     = 1
+	ResponseTypeChannelMessageWithSource = 4
+
+	optionTypeString = 3
+
+	messageFlagEphemeral = 1 << 6
+)
+
+// Color is the color of the embeds, indexed by the Keirsey
+// temperament of the personality.
+var Color = map[mbti.Temperament]int{
+	mbti.TemperamentNT: 0x88619a,
+	mbti.TemperamentNF: 0x33a474,
+	mbti.TemperamentSJ: 0x4298b4,
+	mbti.TemperamentSP: 0xe4ae3a,
+}
+
+// ApplicationCommandOption is an option of an application command.
+type ApplicationCommandOption struct {
+	Type        int    `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// ApplicationCommand is the definition used to register a slash command.
+type ApplicationCommand struct {
+	Name        string                     `json:"name"`
+	Description string                     `json:"description"`
+	Options     []ApplicationCommandOption `json:"options,omitempty"`
+}
+
+// Command is the definition of the "/mbti" slash command, ready to be
+// registered through the Discord API.
+var Command = ApplicationCommand{
+	Name:        CommandName,
+	Description: "Show the cognitive functions of a Myers-Briggs personality type",
+	Options: []ApplicationCommandOption{{
+		Type:        optionTypeString,
+		Name:        optionType,
+		Description: "A type indicator (e.g. INFJ) or dominant functions (e.g. NiFe)",
+		Required:    true,
+	}},
+}
+
+// InteractionDataOption is an option value received with a slash command.
+type InteractionDataOption struct {
+	Name  string      `json:"name"`
+	Type  int         `json:"type"`
+	Value interface{} `json:"value"`
+}
+
+// InteractionData is the data of an application command interaction.
+type InteractionData struct {
+	Name    string                  `json:"name"`
+	Options []InteractionDataOption `json:"options"`
+}
+
+// Interaction is an interaction received from Discord.
+type Interaction struct {
+	Type int              `json:"type"`
+	Data *InteractionData `json:"data,omitempty"`
+}
+
+// EmbedField is a field of a message embed.
+type EmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// Embed is a rich message embed.
+type Embed struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description,omitempty"`
+	Color       int          `json:"color,omitempty"`
+	Fields      []EmbedField `json:"fields,omitempty"`
+}
+
+// InteractionResponseData is the message sent in response to an interaction.
+type InteractionResponseData struct {
+	Content string  `json:"content,omitempty"`
+	Embeds  []Embed `json:"embeds,omitempty"`
+	Flags   int     `json:"flags,omitempty"`
+}
+
+// InteractionResponse is the response sent to an interaction.
+type InteractionResponse struct {
+	Type int                      `json:"type"`
+	Data *InteractionResponseData `json:"data,omitempty"`
+}
+
+var (
+	ErrUnknownCommand = errors.New("unknown command")
+	ErrMissingOption  = errors.New("missing option")
+)
+
+// ParseCommand extracts the personality requested by a "/mbti" command.
+func ParseCommand(data *InteractionData) (*mbti.Personality, error) {
+	if data == nil || data.Name != CommandName {
+		return nil, ErrUnknownCommand
+	}
+
+	for _, o := range data.Options {
+		if o.Name != optionType {
+			continue
+		}
+
+		value, _ := o.Value.(string)
+
+		return mbti.Parse(strings.TrimSpace(value))
+	}
+
+	return nil, fmt.Errorf("%w %q", ErrMissingOption, optionType)
+}
+
+// NewEmbed formats the given personality's mind as a message embed.
+func NewEmbed(p *mbti.Personality) Embed {
+	m := mbti.NewMind(p)
+	field := func(name string, p *mbti.Personality) EmbedField {
+		return EmbedField{Name: name, Value: fmt.Sprintf("%s (%s)", p, mbti.JoinFunctions(p.Functions(), " · ")), Inline: true}
+	}
+
+	return Embed{
+		Title:       m.Ego.String(),
+		Description: mbti.JoinFunctions(m.Ego.Functions(), " · "),
+		Color:       Color[p.Temperament()],
+		Fields: []EmbedField{
+			field("Unconscious", m.Unconscious),
+			field("Subconscious", m.Subconscious),
+			field("Super-ego", m.SuperEgo),
+		},
+	}
+}
+
+// Respond builds the response to the given interaction. Invalid input
+// is reported back to the user with an ephemeral message.
+func Respond(i *Interaction) *InteractionResponse {
+	if i.Type == InteractionTypePing {
+		return &InteractionResponse{Type: ResponseTypePong}
+	}
+
+	p, err := ParseCommand(i.Data)
+	if err != nil {
+		return &InteractionResponse{
+			Type: ResponseTypeChannelMessageWithSource,
+			Data: &InteractionResponseData{Content: err.Error(), Flags: messageFlagEphemeral},
+		}
+	}
+
+	return &InteractionResponse{
+		Type: ResponseTypeChannelMessageWithSource,
+		Data: &InteractionResponseData{Embeds: []Embed{NewEmbed(p)}},
+	}
+}
@@ -0,0 +1,78 @@
+package discord
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// maxBodySize is the maximum accepted size of an interaction payload.
+const maxBodySize = 1 << 20
+
+type handler struct {
+	publicKey ed25519.PublicKey
+}
+
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// NewHandler creates an HTTP handler for an interactions endpoint. Requests
+// are verified using the application's public key, as Discord requires.
+// It returns ErrInvalidPublicKey if the key doesn't have the size of an
+// Ed25519 public key.
+func NewHandler(publicKey ed25519.PublicKey) (http.Handler, error) {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidPublicKey, ed25519.PublicKeySize, len(publicKey))
+	}
+
+	return &handler{publicKey: publicKey}, nil
+}
+
+func (h *handler) verify(r *http.Request, body []byte) bool {
+	signature, err := hex.DecodeString(r.Header.Get("X-Signature-Ed25519"))
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return false
+	}
+
+	var msg bytes.Buffer
+	msg.WriteString(r.Header.Get("X-Signature-Timestamp"))
+	msg.Write(body)
+
+	return ed25519.Verify(h.publicKey, msg.Bytes(), signature)
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if !h.verify(r, body) {
+		http.Error(w, "invalid request signature", http.StatusUnauthorized)
+
+		return
+	}
+
+	var i Interaction
+	if err := json.Unmarshal(body, &i); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(Respond(&i))
+}
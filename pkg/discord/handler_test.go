@@ -0,0 +1,22 @@
+package discord
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+func TestNewHandlerKeySize(t *testing.T) {
+	if _, err := NewHandler(ed25519.PublicKey("too short")); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("got error %v, want %v", err, ErrInvalidPublicKey)
+	}
+
+	key, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewHandler(key); err != nil {
+		t.Fatal(err)
+	}
+}
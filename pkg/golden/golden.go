@@ -0,0 +1,343 @@
+/*
+Package golden runs CLI commands with a fake clock and records their output
+with the time each part was written at, producing transcripts that can be
+compared against golden files. Both the content and the pacing of the
+typewriter output are checked, without waiting for the real delays:
+
+	func TestExplain(t *testing.T) {
+		tr, err := golden.Run(runExplain, []string{"INTJ"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		golden.Assert(t, "testdata/explain.golden", tr)
+	}
+
+Run the tests with UPDATE_GOLDEN=1 to create or update the golden files.
+
+Commands are run in-process with a delayedtest.Clock, which is stepped
+each time the command waits for it. Run is for commands that use the
+standard streams and delayed.DefaultClock: it replaces them with files and
+the fake clock, so only one such command runs at a time. RunProgram gives
+the streams and the clock to the program instead, so tests using it can
+run in parallel:
+
+	func TestGreet(t *testing.T) {
+		t.Parallel()
+
+		tr, err := golden.RunProgram(func(env golden.Env, args []string) error {
+			d := delayed.New(delayed.Properties{Writer: env.Stdout, Clock: env.Clock, PrintDuration: time.Second})
+
+			return <-d.Write("hello, %s\n", args[0]).Do()
+		}, []string{"world"})
+		...
+	}
+
+Commands that disable the typewriter effect when the output isn't a
+terminal must be told to keep it for the pacing to be recorded.
+*/
+package golden
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rivo/uniseg"
+	"github.com/tmaxmax/mbti/delayed"
+	"github.com/tmaxmax/mbti/delayed/delayedtest"
+)
+
+// Command is the entry point of a CLI command, receiving its arguments.
+type Command func(args []string) error
+
+// Segment is text written at once.
+type Segment = delayedtest.Write
+
+// newTranscript creates the transcript of the given writes, joining the
+// text written at the same time into a single segment.
+func newTranscript(writes []delayedtest.Write) *Transcript {
+	t := &Transcript{}
+
+	for _, w := range writes {
+		if w.Text == "" {
+			continue
+		}
+
+		if n := len(t.Segments); n > 0 && t.Segments[n-1].At == w.At {
+			t.Segments[n-1].Text += w.Text
+		} else {
+			t.Segments = append(t.Segments, w)
+		}
+	}
+
+	return t
+}
+
+// Transcript is the recorded output of a command.
+type Transcript struct {
+	Segments []Segment
+	// Err is the error returned by the command.
+	Err error
+}
+
+// Output returns the recorded text, without timings.
+func (t *Transcript) Output() string {
+	var b strings.Builder
+	for _, s := range t.Segments {
+		b.WriteString(s.Text)
+	}
+
+	return b.String()
+}
+
+// String formats the transcript for golden files. Each line has the time
+// its text started being written at, the pace of the text and the quoted
+// text. Single graphemes written at a regular interval are joined on one
+// line, whose pace is the interval; text written at once has no pace.
+// Lines end after newlines, and the error of the command, if any, is
+// written on the last line.
+//
+//	0s         -      "Introverted iNtuition\n"
+//	500ms      30ms   "Extraverted"
+func (t *Transcript) String() string {
+	type line struct {
+		at, last, pace time.Duration
+		text           string
+		count          int
+		// graphemes is true if each segment of the line is a single grapheme.
+		graphemes bool
+	}
+
+	var lines []line
+
+	for _, s := range t.Segments {
+		if n := len(lines); n > 0 {
+			l := &lines[n-1]
+			gap := s.At - l.last
+
+			if l.graphemes && isGrapheme(s.Text) && !strings.HasSuffix(l.text, "\n") && (l.count == 1 || gap == l.pace) {
+				l.pace, l.last, l.text = gap, s.At, l.text+s.Text
+				l.count++
+
+				continue
+			}
+		}
+
+		lines = append(lines, line{at: s.At, last: s.At, text: s.Text, count: 1, graphemes: isGrapheme(s.Text)})
+	}
+
+	var b strings.Builder
+
+	for _, l := range lines {
+		pace := "-"
+		if l.count > 1 {
+			pace = l.pace.String()
+		}
+
+		fmt.Fprintf(&b, "%-10s %-6s %q\n", l.at, pace, l.text)
+	}
+
+	if t.Err != nil {
+		fmt.Fprintf(&b, "error: %v\n", t.Err)
+	}
+
+	return b.String()
+}
+
+func isGrapheme(s string) bool {
+	return s != "" && uniseg.GraphemeClusterCount(s) == 1
+}
+
+// Options configure how a command is run.
+type Options struct {
+	// Stdin is the standard input of the command.
+	Stdin string
+	// Start is the time the fake clock starts at. Defaults to the Unix epoch.
+	Start time.Time
+}
+
+func options(opts []Options) Options {
+	var o Options
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+
+	if o.Start.IsZero() {
+		o.Start = time.Unix(0, 0).UTC()
+	}
+
+	return o
+}
+
+// Env holds the standard streams and the clock of a Program.
+type Env struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	// Clock is the clock the program measures its delays with, such as
+	// the one of the delayed.Properties of its typewriter.
+	Clock delayed.Clock
+}
+
+// Program is the entry point of a command that uses the streams and the
+// clock of the given environment instead of the global ones.
+type Program func(env Env, args []string) error
+
+// RunProgram runs the program with a fake clock and records its standard
+// output. Unlike Run, it doesn't replace any global, so programs can run
+// in parallel. The error of the program is stored in the transcript.
+func RunProgram(p Program, args []string, opts ...Options) (*Transcript, error) {
+	o := options(opts)
+
+	clock := delayedtest.NewClock(o.Start)
+	rec := delayedtest.NewRecorder(clock)
+	env := Env{Stdin: strings.NewReader(o.Stdin), Stdout: rec, Clock: clock}
+
+	done := make(chan error, 1)
+	go func() { done <- p(env, args) }()
+
+	err := clock.Drive(done)
+
+	t := newTranscript(rec.Writes())
+	t.Err = err
+
+	return t, nil
+}
+
+// runMu serializes commands, as they share the standard streams.
+var runMu sync.Mutex
+
+// Run runs the command with a fake clock and records its standard output.
+// The error of the command is stored in the transcript; the returned error
+// is about recording it.
+func Run(cmd Command, args []string, opts ...Options) (*Transcript, error) {
+	runMu.Lock()
+	defer runMu.Unlock()
+
+	o := options(opts)
+
+	dir, err := os.MkdirTemp("", "golden")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	stdin, err := createFile(filepath.Join(dir, "stdin"), o.Stdin)
+	if err != nil {
+		return nil, err
+	}
+	defer stdin.Close()
+
+	stdout, err := createFile(filepath.Join(dir, "stdout"), "")
+	if err != nil {
+		return nil, err
+	}
+	defer stdout.Close()
+
+	output, err := os.Open(stdout.Name())
+	if err != nil {
+		return nil, err
+	}
+	defer output.Close()
+
+	clock := delayedtest.NewClock(o.Start)
+	rec := delayedtest.NewRecorder(clock)
+
+	var captureErr error
+
+	// Everything written to the standard output since the last delay
+	// was written before the time advances, so it is recorded now.
+	capture := func() {
+		if _, err := io.Copy(rec, output); err != nil && captureErr == nil {
+			captureErr = err
+		}
+	}
+
+	prevStdin, prevStdout, prevClock := os.Stdin, os.Stdout, delayed.DefaultClock
+	os.Stdin, os.Stdout, delayed.DefaultClock = stdin, stdout, clock
+
+	done := make(chan error, 1)
+	go func() { done <- cmd(args) }()
+
+	cmdErr := clock.DriveFunc(done, capture)
+
+	os.Stdin, os.Stdout, delayed.DefaultClock = prevStdin, prevStdout, prevClock
+
+	capture()
+
+	if captureErr != nil {
+		return nil, captureErr
+	}
+
+	t := newTranscript(rec.Writes())
+	t.Err = cmdErr
+
+	return t, nil
+}
+
+func createFile(path, contents string) (*os.File, error) {
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		return nil, err
+	}
+
+	return os.OpenFile(path, os.O_RDWR, 0)
+}
+
+// UpdateEnv is the environment variable that makes Assert write the golden
+// files instead of comparing against them, when set to a non-empty value.
+const UpdateEnv = "UPDATE_GOLDEN"
+
+// Assert compares the transcript against the golden file at the given path.
+func Assert(tb testing.TB, path string, t *Transcript) {
+	tb.Helper()
+
+	got := t.String()
+
+	if os.Getenv(UpdateEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			tb.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			tb.Fatal(err)
+		}
+
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("%v (run with %s=1 to create the golden file)", err, UpdateEnv)
+	}
+
+	if line, w, g, ok := firstDifference(string(want), got); ok {
+		tb.Errorf("transcript differs from %s at line %d:\n want: %s\n  got: %s", path, line, w, g)
+	}
+}
+
+// firstDifference returns the first line that differs between the two texts.
+func firstDifference(want, got string) (line int, w, g string, ok bool) {
+	wantLines, gotLines := strings.Split(want, "\n"), strings.Split(got, "\n")
+
+	for i := 0; i < len(wantLines) || i < len(gotLines); i++ {
+		w, g = "<end>", "<end>"
+		if i < len(wantLines) {
+			w = wantLines[i]
+		}
+
+		if i < len(gotLines) {
+			g = gotLines[i]
+		}
+
+		if w != g {
+			return i + 1, w, g, true
+		}
+	}
+
+	return 0, "", "", false
+}
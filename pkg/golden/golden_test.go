@@ -0,0 +1,61 @@
+package golden_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/tmaxmax/mbti/delayed"
+	"github.com/tmaxmax/mbti/pkg/golden"
+)
+
+func greet(env golden.Env, args []string) error {
+	if len(args) == 0 {
+		return errors.New("no name given")
+	}
+
+	d := delayed.New(delayed.Properties{Writer: env.Stdout, Clock: env.Clock, PrintDuration: 40 * time.Millisecond})
+
+	return <-d.Write("hey\n").Wait(time.Second).Write("%s!\n", args[0]).Do()
+}
+
+func TestRunProgram(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"paced", []string{"gopher"}, "0s         10ms   \"hey\\n\"\n1.04s      5ms    \"gopher!\\n\"\n"},
+		{"error", nil, "error: no name given\n"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr, err := golden.RunProgram(greet, tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := tr.String(); got != tt.want {
+				t.Fatalf("got transcript\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleRunProgram() {
+	tr, err := golden.RunProgram(greet, []string{"gopher"})
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(tr)
+	// Output:
+	// 0s         10ms   "hey\n"
+	// 1.04s      5ms    "gopher!\n"
+}
@@ -0,0 +1,165 @@
+/*
+Package mbtihttp provides an HTTP middleware that reads the personality
+of the visitor from the request into its context, so web applications
+can personalize content by type:
+
+	mw := mbtihttp.Middleware(mbtihttp.Options{
+		Sources: []mbtihttp.Source{mbtihttp.Query("type"), mbtihttp.Cookie("mbti")},
+	})
+
+	http.Handle("/", mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if p, ok := mbtihttp.FromContext(r.Context()); ok {
+			fmt.Fprintf(w, "Hello, %s!", p)
+		}
+	})))
+
+The input can be a type indicator or a pair of dominant functions,
+as accepted by mbti.Parse.
+*/
+package mbtihttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// ErrMissing is passed to the ErrorHandler if the personality is required
+// and none of the sources hold a value.
+var ErrMissing = errors.New("no personality in request")
+
+// Source extracts the raw personality input from a request.
+type Source func(r *http.Request) (string, bool)
+
+// Header reads the personality from the request header with the given name.
+func Header(name string) Source {
+	return func(r *http.Request) (string, bool) {
+		v := r.Header.Get(name)
+
+		return v, v != ""
+	}
+}
+
+// Cookie reads the personality from the cookie with the given name.
+func Cookie(name string) Source {
+	return func(r *http.Request) (string, bool) {
+		c, err := r.Cookie(name)
+		if err != nil || c.Value == "" {
+			return "", false
+		}
+
+		return c.Value, true
+	}
+}
+
+// Query reads the personality from the URL query parameter with the given name.
+func Query(name string) Source {
+	return func(r *http.Request) (string, bool) {
+		v := r.URL.Query().Get(name)
+
+		return v, v != ""
+	}
+}
+
+// DefaultSources are the sources used if none are given: the X-MBTI-Type
+// header, the "mbti" cookie and the "mbti" query parameter, in this order.
+var DefaultSources = []Source{Header("X-MBTI-Type"), Cookie("mbti"), Query("mbti")}
+
+// Options configure the middleware.
+type Options struct {
+	// Sources are tried in order; the first one holding a value is used.
+	Sources []Source
+	// Required rejects the requests without a valid personality.
+	Required bool
+	// ErrorHandler responds to the requests whose personality is invalid, or missing
+	// if Required is set. By default such requests get a 400 Bad Request response.
+	// Invalid personalities of requests that don't require one are ignored.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// Middleware returns a middleware that stores the personality of each request in its context.
+func Middleware(opts Options) func(http.Handler) http.Handler {
+	sources := opts.Sources
+	if len(sources) == 0 {
+		sources = DefaultSources
+	}
+
+	onError := opts.ErrorHandler
+	if onError == nil {
+		onError = func(w http.ResponseWriter, _ *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, err := fromRequest(r, sources)
+			if err != nil {
+				if opts.Required {
+					onError(w, r, err)
+
+					return
+				}
+
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), p)))
+		})
+	}
+}
+
+func fromRequest(r *http.Request, sources []Source) (*mbti.Personality, error) {
+	for _, source := range sources {
+		if input, ok := source(r); ok {
+			return mbti.Parse(input)
+		}
+	}
+
+	return nil, ErrMissing
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of the context holding the personality.
+func NewContext(ctx context.Context, p *mbti.Personality) context.Context {
+	return context.WithValue(ctx, contextKey{}, p)
+}
+
+// FromContext returns the personality stored in the context, if any.
+func FromContext(ctx context.Context) (*mbti.Personality, bool) {
+	p, ok := ctx.Value(contextKey{}).(*mbti.Personality)
+
+	return p, ok && p != nil
+}
+
+// Indicator returns the indicator of the personality in the context, or an empty string.
+func Indicator(ctx context.Context) string {
+	if p, ok := FromContext(ctx); ok {
+		return p.String()
+	}
+
+	return ""
+}
+
+// Temperament returns the temperament of the personality in the context.
+func Temperament(ctx context.Context) (mbti.Temperament, bool) {
+	if p, ok := FromContext(ctx); ok {
+		return p.Temperament(), true
+	}
+
+	return "", false
+}
+
+// Dominant returns the dominant function of the personality in the context.
+func Dominant(ctx context.Context) (mbti.Function, bool) {
+	if p, ok := FromContext(ctx); ok {
+		return p.Functions()[mbti.PositionDominant], true
+	}
+
+	return mbti.Function{}, false
+}
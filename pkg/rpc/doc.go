@@ -0,0 +1,8 @@
+/*
+Package rpc contains the protobuf schema of the mbti gRPC service,
+the code generated from it and a server implementation backed by the
+mbti library.
+*/
+package rpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative mbti.proto
@@ -0,0 +1,1215 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.20.1
+// source: mbti.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Dichotomy int32
+
+const (
+	Dichotomy_DICHOTOMY_ATTITUDE   Dichotomy = 0
+	Dichotomy_DICHOTOMY_PERCEPTION Dichotomy = 1
+	Dichotomy_DICHOTOMY_JUDGEMENT  Dichotomy = 2
+	Dichotomy_DICHOTOMY_LIFESTYLE  Dichotomy = 3
+)
+
+// Enum value maps for Dichotomy.
+var (
+	Dichotomy_name = map[int32]string{
+		0: "DICHOTOMY_ATTITUDE",
+		1: "DICHOTOMY_PERCEPTION",
+		2: "DICHOTOMY_JUDGEMENT",
+		3: "DICHOTOMY_LIFESTYLE",
+	}
+	Dichotomy_value = map[string]int32{
+		"DICHOTOMY_ATTITUDE":   0,
+		"DICHOTOMY_PERCEPTION": 1,
+		"DICHOTOMY_JUDGEMENT":  2,
+		"DICHOTOMY_LIFESTYLE":  3,
+	}
+)
+
+func (x Dichotomy) Enum() *Dichotomy {
+	p := new(Dichotomy)
+	*p = x
+	return p
+}
+
+func (x Dichotomy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Dichotomy) Descriptor() protoreflect.EnumDescriptor {
+	return file_mbti_proto_enumTypes[0].Descriptor()
+}
+
+func (Dichotomy) Type() protoreflect.EnumType {
+	return &file_mbti_proto_enumTypes[0]
+}
+
+func (x Dichotomy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Dichotomy.Descriptor instead.
+func (Dichotomy) EnumDescriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{0}
+}
+
+type Personality struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indicator string   `protobuf:"bytes,1,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	Functions []string `protobuf:"bytes,2,rep,name=functions,proto3" json:"functions,omitempty"`
+}
+
+func (x *Personality) Reset() {
+	*x = Personality{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Personality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Personality) ProtoMessage() {}
+
+func (x *Personality) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Personality.ProtoReflect.Descriptor instead.
+func (*Personality) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Personality) GetIndicator() string {
+	if x != nil {
+		return x.Indicator
+	}
+	return ""
+}
+
+func (x *Personality) GetFunctions() []string {
+	if x != nil {
+		return x.Functions
+	}
+	return nil
+}
+
+type Mind struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ego          *Personality `protobuf:"bytes,1,opt,name=ego,proto3" json:"ego,omitempty"`
+	Unconscious  *Personality `protobuf:"bytes,2,opt,name=unconscious,proto3" json:"unconscious,omitempty"`
+	Subconscious *Personality `protobuf:"bytes,3,opt,name=subconscious,proto3" json:"subconscious,omitempty"`
+	SuperEgo     *Personality `protobuf:"bytes,4,opt,name=super_ego,json=superEgo,proto3" json:"super_ego,omitempty"`
+}
+
+func (x *Mind) Reset() {
+	*x = Mind{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mind) ProtoMessage() {}
+
+func (x *Mind) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mind.ProtoReflect.Descriptor instead.
+func (*Mind) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Mind) GetEgo() *Personality {
+	if x != nil {
+		return x.Ego
+	}
+	return nil
+}
+
+func (x *Mind) GetUnconscious() *Personality {
+	if x != nil {
+		return x.Unconscious
+	}
+	return nil
+}
+
+func (x *Mind) GetSubconscious() *Personality {
+	if x != nil {
+		return x.Subconscious
+	}
+	return nil
+}
+
+func (x *Mind) GetSuperEgo() *Personality {
+	if x != nil {
+		return x.SuperEgo
+	}
+	return nil
+}
+
+type GetMindRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Input string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+func (x *GetMindRequest) Reset() {
+	*x = GetMindRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMindRequest) ProtoMessage() {}
+
+func (x *GetMindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMindRequest.ProtoReflect.Descriptor instead.
+func (*GetMindRequest) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetMindRequest) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+type ListPersonalitiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListPersonalitiesRequest) Reset() {
+	*x = ListPersonalitiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPersonalitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonalitiesRequest) ProtoMessage() {}
+
+func (x *ListPersonalitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonalitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListPersonalitiesRequest) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{3}
+}
+
+type ListPersonalitiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Personalities []*Personality `protobuf:"bytes,1,rep,name=personalities,proto3" json:"personalities,omitempty"`
+}
+
+func (x *ListPersonalitiesResponse) Reset() {
+	*x = ListPersonalitiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPersonalitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPersonalitiesResponse) ProtoMessage() {}
+
+func (x *ListPersonalitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPersonalitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListPersonalitiesResponse) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListPersonalitiesResponse) GetPersonalities() []*Personality {
+	if x != nil {
+		return x.Personalities
+	}
+	return nil
+}
+
+type CompareRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A string `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B string `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+}
+
+func (x *CompareRequest) Reset() {
+	*x = CompareRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRequest) ProtoMessage() {}
+
+func (x *CompareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRequest.ProtoReflect.Descriptor instead.
+func (*CompareRequest) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CompareRequest) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *CompareRequest) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+type Comparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	A               *Personality `protobuf:"bytes,1,opt,name=a,proto3" json:"a,omitempty"`
+	B               *Personality `protobuf:"bytes,2,opt,name=b,proto3" json:"b,omitempty"`
+	SharedFunctions []string     `protobuf:"bytes,3,rep,name=shared_functions,json=sharedFunctions,proto3" json:"shared_functions,omitempty"`
+	SamePosition    []string     `protobuf:"bytes,4,rep,name=same_position,json=samePosition,proto3" json:"same_position,omitempty"`
+}
+
+func (x *Comparison) Reset() {
+	*x = Comparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comparison) ProtoMessage() {}
+
+func (x *Comparison) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comparison.ProtoReflect.Descriptor instead.
+func (*Comparison) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Comparison) GetA() *Personality {
+	if x != nil {
+		return x.A
+	}
+	return nil
+}
+
+func (x *Comparison) GetB() *Personality {
+	if x != nil {
+		return x.B
+	}
+	return nil
+}
+
+func (x *Comparison) GetSharedFunctions() []string {
+	if x != nil {
+		return x.SharedFunctions
+	}
+	return nil
+}
+
+func (x *Comparison) GetSamePosition() []string {
+	if x != nil {
+		return x.SamePosition
+	}
+	return nil
+}
+
+type Question struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Text      string    `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Dichotomy Dichotomy `protobuf:"varint,3,opt,name=dichotomy,proto3,enum=mbti.v1.Dichotomy" json:"dichotomy,omitempty"`
+	Choices   []string  `protobuf:"bytes,4,rep,name=choices,proto3" json:"choices,omitempty"`
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Question) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Question) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Question) GetDichotomy() Dichotomy {
+	if x != nil {
+		return x.Dichotomy
+	}
+	return Dichotomy_DICHOTOMY_ATTITUDE
+}
+
+func (x *Question) GetChoices() []string {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+type GetQuestionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetQuestionsRequest) Reset() {
+	*x = GetQuestionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionsRequest) ProtoMessage() {}
+
+func (x *GetQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*GetQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{8}
+}
+
+type GetQuestionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Questions []*Question `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+}
+
+func (x *GetQuestionsResponse) Reset() {
+	*x = GetQuestionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuestionsResponse) ProtoMessage() {}
+
+func (x *GetQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*GetQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetQuestionsResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+type Answer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuestionId string `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Choice     int32  `protobuf:"varint,2,opt,name=choice,proto3" json:"choice,omitempty"`
+}
+
+func (x *Answer) Reset() {
+	*x = Answer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Answer) ProtoMessage() {}
+
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Answer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *Answer) GetChoice() int32 {
+	if x != nil {
+		return x.Choice
+	}
+	return 0
+}
+
+type ScoreQuizRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Answers []*Answer `protobuf:"bytes,1,rep,name=answers,proto3" json:"answers,omitempty"`
+}
+
+func (x *ScoreQuizRequest) Reset() {
+	*x = ScoreQuizRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScoreQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreQuizRequest) ProtoMessage() {}
+
+func (x *ScoreQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreQuizRequest.ProtoReflect.Descriptor instead.
+func (*ScoreQuizRequest) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ScoreQuizRequest) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type Score struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dichotomy Dichotomy `protobuf:"varint,1,opt,name=dichotomy,proto3,enum=mbti.v1.Dichotomy" json:"dichotomy,omitempty"`
+	First     int32     `protobuf:"varint,2,opt,name=first,proto3" json:"first,omitempty"`
+	Second    int32     `protobuf:"varint,3,opt,name=second,proto3" json:"second,omitempty"`
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Score) GetDichotomy() Dichotomy {
+	if x != nil {
+		return x.Dichotomy
+	}
+	return Dichotomy_DICHOTOMY_ATTITUDE
+}
+
+func (x *Score) GetFirst() int32 {
+	if x != nil {
+		return x.First
+	}
+	return 0
+}
+
+func (x *Score) GetSecond() int32 {
+	if x != nil {
+		return x.Second
+	}
+	return 0
+}
+
+type QuizResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indicator string   `protobuf:"bytes,1,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	Scores    []*Score `protobuf:"bytes,2,rep,name=scores,proto3" json:"scores,omitempty"`
+	Mind      *Mind    `protobuf:"bytes,3,opt,name=mind,proto3" json:"mind,omitempty"`
+}
+
+func (x *QuizResult) Reset() {
+	*x = QuizResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mbti_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizResult) ProtoMessage() {}
+
+func (x *QuizResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mbti_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizResult.ProtoReflect.Descriptor instead.
+func (*QuizResult) Descriptor() ([]byte, []int) {
+	return file_mbti_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QuizResult) GetIndicator() string {
+	if x != nil {
+		return x.Indicator
+	}
+	return ""
+}
+
+func (x *QuizResult) GetScores() []*Score {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *QuizResult) GetMind() *Mind {
+	if x != nil {
+		return x.Mind
+	}
+	return nil
+}
+
+var File_mbti_proto protoreflect.FileDescriptor
+
+var file_mbti_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6d, 0x62,
+	0x74, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x49, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xd3, 0x01, 0x0a, 0x04, 0x4d, 0x69, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x65, 0x67, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x03, 0x65, 0x67,
+	0x6f, 0x12, 0x36, 0x0a, 0x0b, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x73, 0x63, 0x69, 0x6f, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0b, 0x75, 0x6e,
+	0x63, 0x6f, 0x6e, 0x73, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x73, 0x75, 0x62,
+	0x63, 0x6f, 0x6e, 0x73, 0x63, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x63, 0x6f, 0x6e, 0x73, 0x63, 0x69,
+	0x6f, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x09, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x67, 0x6f,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x45, 0x67, 0x6f, 0x22, 0x26, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x1a,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x19, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x0d, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x22, 0x2c, 0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01,
+	0x62, 0x22, 0xa4, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x01, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x62,
+	0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x52, 0x01, 0x61, 0x12, 0x22, 0x0a, 0x01, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x01, 0x62, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x65,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7a, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x64, 0x69, 0x63, 0x68,
+	0x6f, 0x74, 0x6f, 0x6d, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x62,
+	0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x63, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x79, 0x52,
+	0x09, 0x64, 0x69, 0x63, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x6f,
+	0x69, 0x63, 0x65, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x47, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x3d, 0x0a, 0x10, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
+	0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x67, 0x0a, 0x05, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x30, 0x0a, 0x09, 0x64, 0x69, 0x63, 0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x63,
+	0x68, 0x6f, 0x74, 0x6f, 0x6d, 0x79, 0x52, 0x09, 0x64, 0x69, 0x63, 0x68, 0x6f, 0x74, 0x6f, 0x6d,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x66, 0x69, 0x72, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x22,
+	0x75, 0x0a, 0x0a, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x06, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x6d, 0x62,
+	0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x04, 0x6d, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x6d, 0x69, 0x6e, 0x64, 0x2a, 0x6f, 0x0a, 0x09, 0x44, 0x69, 0x63, 0x68, 0x6f, 0x74,
+	0x6f, 0x6d, 0x79, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x43, 0x48, 0x4f, 0x54, 0x4f, 0x4d, 0x59,
+	0x5f, 0x41, 0x54, 0x54, 0x49, 0x54, 0x55, 0x44, 0x45, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x44,
+	0x49, 0x43, 0x48, 0x4f, 0x54, 0x4f, 0x4d, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x43, 0x48, 0x4f, 0x54, 0x4f,
+	0x4d, 0x59, 0x5f, 0x4a, 0x55, 0x44, 0x47, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x44, 0x49, 0x43, 0x48, 0x4f, 0x54, 0x4f, 0x4d, 0x59, 0x5f, 0x4c, 0x49, 0x46, 0x45,
+	0x53, 0x54, 0x59, 0x4c, 0x45, 0x10, 0x03, 0x32, 0xe6, 0x02, 0x0a, 0x12, 0x50, 0x65, 0x72, 0x73,
+	0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x2e, 0x6d, 0x62, 0x74, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
+	0x64, 0x12, 0x5a, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x62, 0x74, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x07, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x17, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12, 0x4b, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65,
+	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x51, 0x75, 0x69, 0x7a,
+	0x12, 0x19, 0x2e, 0x6d, 0x62, 0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x6d, 0x62,
+	0x74, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x6d, 0x61, 0x78, 0x6d, 0x61, 0x78, 0x2f, 0x6d, 0x62, 0x74, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mbti_proto_rawDescOnce sync.Once
+	file_mbti_proto_rawDescData = file_mbti_proto_rawDesc
+)
+
+func file_mbti_proto_rawDescGZIP() []byte {
+	file_mbti_proto_rawDescOnce.Do(func() {
+		file_mbti_proto_rawDescData = protoimpl.X.CompressGZIP(file_mbti_proto_rawDescData)
+	})
+	return file_mbti_proto_rawDescData
+}
+
+var file_mbti_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_mbti_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_mbti_proto_goTypes = []interface{}{
+	(Dichotomy)(0),                    // 0: mbti.v1.Dichotomy
+	(*Personality)(nil),               // 1: mbti.v1.Personality
+	(*Mind)(nil),                      // 2: mbti.v1.Mind
+	(*GetMindRequest)(nil),            // 3: mbti.v1.GetMindRequest
+	(*ListPersonalitiesRequest)(nil),  // 4: mbti.v1.ListPersonalitiesRequest
+	(*ListPersonalitiesResponse)(nil), // 5: mbti.v1.ListPersonalitiesResponse
+	(*CompareRequest)(nil),            // 6: mbti.v1.CompareRequest
+	(*Comparison)(nil),                // 7: mbti.v1.Comparison
+	(*Question)(nil),                  // 8: mbti.v1.Question
+	(*GetQuestionsRequest)(nil),       // 9: mbti.v1.GetQuestionsRequest
+	(*GetQuestionsResponse)(nil),      // 10: mbti.v1.GetQuestionsResponse
+	(*Answer)(nil),                    // 11: mbti.v1.Answer
+	(*ScoreQuizRequest)(nil),          // 12: mbti.v1.ScoreQuizRequest
+	(*Score)(nil),                     // 13: mbti.v1.Score
+	(*QuizResult)(nil),                // 14: mbti.v1.QuizResult
+}
+var file_mbti_proto_depIdxs = []int32{
+	1,  // 0: mbti.v1.Mind.ego:type_name -> mbti.v1.Personality
+	1,  // 1: mbti.v1.Mind.unconscious:type_name -> mbti.v1.Personality
+	1,  // 2: mbti.v1.Mind.subconscious:type_name -> mbti.v1.Personality
+	1,  // 3: mbti.v1.Mind.super_ego:type_name -> mbti.v1.Personality
+	1,  // 4: mbti.v1.ListPersonalitiesResponse.personalities:type_name -> mbti.v1.Personality
+	1,  // 5: mbti.v1.Comparison.a:type_name -> mbti.v1.Personality
+	1,  // 6: mbti.v1.Comparison.b:type_name -> mbti.v1.Personality
+	0,  // 7: mbti.v1.Question.dichotomy:type_name -> mbti.v1.Dichotomy
+	8,  // 8: mbti.v1.GetQuestionsResponse.questions:type_name -> mbti.v1.Question
+	11, // 9: mbti.v1.ScoreQuizRequest.answers:type_name -> mbti.v1.Answer
+	0,  // 10: mbti.v1.Score.dichotomy:type_name -> mbti.v1.Dichotomy
+	13, // 11: mbti.v1.QuizResult.scores:type_name -> mbti.v1.Score
+	2,  // 12: mbti.v1.QuizResult.mind:type_name -> mbti.v1.Mind
+	3,  // 13: mbti.v1.PersonalityService.GetMind:input_type -> mbti.v1.GetMindRequest
+	4,  // 14: mbti.v1.PersonalityService.ListPersonalities:input_type -> mbti.v1.ListPersonalitiesRequest
+	6,  // 15: mbti.v1.PersonalityService.Compare:input_type -> mbti.v1.CompareRequest
+	9,  // 16: mbti.v1.PersonalityService.GetQuestions:input_type -> mbti.v1.GetQuestionsRequest
+	12, // 17: mbti.v1.PersonalityService.ScoreQuiz:input_type -> mbti.v1.ScoreQuizRequest
+	2,  // 18: mbti.v1.PersonalityService.GetMind:output_type -> mbti.v1.Mind
+	5,  // 19: mbti.v1.PersonalityService.ListPersonalities:output_type -> mbti.v1.ListPersonalitiesResponse
+	7,  // 20: mbti.v1.PersonalityService.Compare:output_type -> mbti.v1.Comparison
+	10, // 21: mbti.v1.PersonalityService.GetQuestions:output_type -> mbti.v1.GetQuestionsResponse
+	14, // 22: mbti.v1.PersonalityService.ScoreQuiz:output_type -> mbti.v1.QuizResult
+	18, // [18:23] is the sub-list for method output_type
+	13, // [13:18] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_mbti_proto_init() }
+func file_mbti_proto_init() {
+	if File_mbti_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mbti_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Personality); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mind); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMindRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPersonalitiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPersonalitiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Question); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuestionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuestionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Answer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScoreQuizRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Score); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mbti_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mbti_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mbti_proto_goTypes,
+		DependencyIndexes: file_mbti_proto_depIdxs,
+		EnumInfos:         file_mbti_proto_enumTypes,
+		MessageInfos:      file_mbti_proto_msgTypes,
+	}.Build()
+	File_mbti_proto = out.File
+	file_mbti_proto_rawDesc = nil
+	file_mbti_proto_goTypes = nil
+	file_mbti_proto_depIdxs = nil
+}
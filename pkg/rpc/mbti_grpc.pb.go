@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.20.1
+// source: mbti.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PersonalityServiceClient is the client API for PersonalityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PersonalityServiceClient interface {
+	GetMind(ctx context.Context, in *GetMindRequest, opts ...grpc.CallOption) (*Mind, error)
+	ListPersonalities(ctx context.Context, in *ListPersonalitiesRequest, opts ...grpc.CallOption) (*ListPersonalitiesResponse, error)
+	Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*Comparison, error)
+	GetQuestions(ctx context.Context, in *GetQuestionsRequest, opts ...grpc.CallOption) (*GetQuestionsResponse, error)
+	ScoreQuiz(ctx context.Context, in *ScoreQuizRequest, opts ...grpc.CallOption) (*QuizResult, error)
+}
+
+type personalityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPersonalityServiceClient(cc grpc.ClientConnInterface) PersonalityServiceClient {
+	return &personalityServiceClient{cc}
+}
+
+func (c *personalityServiceClient) GetMind(ctx context.Context, in *GetMindRequest, opts ...grpc.CallOption) (*Mind, error) {
+	out := new(Mind)
+	err := c.cc.Invoke(ctx, "/mbti.v1.PersonalityService/GetMind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalityServiceClient) ListPersonalities(ctx context.Context, in *ListPersonalitiesRequest, opts ...grpc.CallOption) (*ListPersonalitiesResponse, error) {
+	out := new(ListPersonalitiesResponse)
+	err := c.cc.Invoke(ctx, "/mbti.v1.PersonalityService/ListPersonalities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalityServiceClient) Compare(ctx context.Context, in *CompareRequest, opts ...grpc.CallOption) (*Comparison, error) {
+	out := new(Comparison)
+	err := c.cc.Invoke(ctx, "/mbti.v1.PersonalityService/Compare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalityServiceClient) GetQuestions(ctx context.Context, in *GetQuestionsRequest, opts ...grpc.CallOption) (*GetQuestionsResponse, error) {
+	out := new(GetQuestionsResponse)
+	err := c.cc.Invoke(ctx, "/mbti.v1.PersonalityService/GetQuestions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *personalityServiceClient) ScoreQuiz(ctx context.Context, in *ScoreQuizRequest, opts ...grpc.CallOption) (*QuizResult, error) {
+	out := new(QuizResult)
+	err := c.cc.Invoke(ctx, "/mbti.v1.PersonalityService/ScoreQuiz", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PersonalityServiceServer is the server API for PersonalityService service.
+// All implementations must embed UnimplementedPersonalityServiceServer
+// for forward compatibility
+type PersonalityServiceServer interface {
+	GetMind(context.Context, *GetMindRequest) (*Mind, error)
+	ListPersonalities(context.Context, *ListPersonalitiesRequest) (*ListPersonalitiesResponse, error)
+	Compare(context.Context, *CompareRequest) (*Comparison, error)
+	GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error)
+	ScoreQuiz(context.Context, *ScoreQuizRequest) (*QuizResult, error)
+	mustEmbedUnimplementedPersonalityServiceServer()
+}
+
+// UnimplementedPersonalityServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPersonalityServiceServer struct {
+}
+
+func (UnimplementedPersonalityServiceServer) GetMind(context.Context, *GetMindRequest) (*Mind, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMind not implemented")
+}
+func (UnimplementedPersonalityServiceServer) ListPersonalities(context.Context, *ListPersonalitiesRequest) (*ListPersonalitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersonalities not implemented")
+}
+func (UnimplementedPersonalityServiceServer) Compare(context.Context, *CompareRequest) (*Comparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Compare not implemented")
+}
+func (UnimplementedPersonalityServiceServer) GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuestions not implemented")
+}
+func (UnimplementedPersonalityServiceServer) ScoreQuiz(context.Context, *ScoreQuizRequest) (*QuizResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScoreQuiz not implemented")
+}
+func (UnimplementedPersonalityServiceServer) mustEmbedUnimplementedPersonalityServiceServer() {}
+
+// UnsafePersonalityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PersonalityServiceServer will
+// result in compilation errors.
+type UnsafePersonalityServiceServer interface {
+	mustEmbedUnimplementedPersonalityServiceServer()
+}
+
+func RegisterPersonalityServiceServer(s grpc.ServiceRegistrar, srv PersonalityServiceServer) {
+	s.RegisterService(&PersonalityService_ServiceDesc, srv)
+}
+
+func _PersonalityService_GetMind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalityServiceServer).GetMind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mbti.v1.PersonalityService/GetMind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalityServiceServer).GetMind(ctx, req.(*GetMindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonalityService_ListPersonalities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPersonalitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalityServiceServer).ListPersonalities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mbti.v1.PersonalityService/ListPersonalities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalityServiceServer).ListPersonalities(ctx, req.(*ListPersonalitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonalityService_Compare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalityServiceServer).Compare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mbti.v1.PersonalityService/Compare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalityServiceServer).Compare(ctx, req.(*CompareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonalityService_GetQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalityServiceServer).GetQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mbti.v1.PersonalityService/GetQuestions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalityServiceServer).GetQuestions(ctx, req.(*GetQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PersonalityService_ScoreQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScoreQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PersonalityServiceServer).ScoreQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mbti.v1.PersonalityService/ScoreQuiz",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PersonalityServiceServer).ScoreQuiz(ctx, req.(*ScoreQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PersonalityService_ServiceDesc is the grpc.ServiceDesc for PersonalityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PersonalityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mbti.v1.PersonalityService",
+	HandlerType: (*PersonalityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMind",
+			Handler:    _PersonalityService_GetMind_Handler,
+		},
+		{
+			MethodName: "ListPersonalities",
+			Handler:    _PersonalityService_ListPersonalities_Handler,
+		},
+		{
+			MethodName: "Compare",
+			Handler:    _PersonalityService_Compare_Handler,
+		},
+		{
+			MethodName: "GetQuestions",
+			Handler:    _PersonalityService_GetQuestions_Handler,
+		},
+		{
+			MethodName: "ScoreQuiz",
+			Handler:    _PersonalityService_ScoreQuiz_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mbti.proto",
+}
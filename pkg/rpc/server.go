@@ -0,0 +1,132 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/internal/api"
+	"github.com/tmaxmax/mbti/quiz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type server struct {
+	UnimplementedPersonalityServiceServer
+
+	questions []quiz.Question
+	model     mbti.Model
+}
+
+// NewServer creates a PersonalityServiceServer that scores quizzes
+// using the given question bank and derives the function stacks of
+// the personalities with the given model.
+func NewServer(questions []quiz.Question, model mbti.Model) PersonalityServiceServer {
+	return &server{questions: questions, model: model}
+}
+
+func (s *server) parse(input string) (*mbti.Personality, error) {
+	p, err := mbti.Parse(input)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return p.WithModel(s.model), nil
+}
+
+func newPersonality(p *mbti.Personality) *Personality {
+	ret := api.NewPersonality(p)
+
+	return &Personality{Indicator: ret.Indicator, Functions: ret.Functions}
+}
+
+func newMind(m *mbti.Mind) *Mind {
+	return &Mind{
+		Ego:          newPersonality(m.Ego),
+		Unconscious:  newPersonality(m.Unconscious),
+		Subconscious: newPersonality(m.Subconscious),
+		SuperEgo:     newPersonality(m.SuperEgo),
+	}
+}
+
+func (s *server) GetMind(_ context.Context, req *GetMindRequest) (*Mind, error) {
+	p, err := s.parse(req.GetInput())
+	if err != nil {
+		return nil, err
+	}
+
+	return newMind(mbti.NewMind(p)), nil
+}
+
+func (s *server) ListPersonalities(context.Context, *ListPersonalitiesRequest) (*ListPersonalitiesResponse, error) {
+	resp := &ListPersonalitiesResponse{}
+
+	for _, p := range mbti.All() {
+		resp.Personalities = append(resp.Personalities, newPersonality(p.WithModel(s.model)))
+	}
+
+	return resp, nil
+}
+
+func (s *server) Compare(_ context.Context, req *CompareRequest) (*Comparison, error) {
+	a, err := s.parse(req.GetA())
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.parse(req.GetB())
+	if err != nil {
+		return nil, err
+	}
+
+	c := mbti.Compare(a, b)
+
+	return &Comparison{
+		A:               newPersonality(c.A),
+		B:               newPersonality(c.B),
+		SharedFunctions: api.FunctionStrings(c.SharedFunctions),
+		SamePosition:    api.FunctionStrings(c.SamePosition),
+	}, nil
+}
+
+func (s *server) GetQuestions(context.Context, *GetQuestionsRequest) (*GetQuestionsResponse, error) {
+	resp := &GetQuestionsResponse{Questions: make([]*Question, 0, len(s.questions))}
+
+	for _, q := range s.questions {
+		resp.Questions = append(resp.Questions, &Question{
+			Id:        q.ID,
+			Text:      q.Text,
+			Dichotomy: Dichotomy(q.Dichotomy),
+			Choices:   q.Choices[:],
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *server) ScoreQuiz(_ context.Context, req *ScoreQuizRequest) (*QuizResult, error) {
+	answers := make([]quiz.Answer, 0, len(req.GetAnswers()))
+	for _, a := range req.GetAnswers() {
+		answers = append(answers, quiz.Answer{QuestionID: a.GetQuestionId(), Choice: int(a.GetChoice())})
+	}
+
+	result, err := quiz.Evaluate(s.questions, answers)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p, err := result.Personality()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &QuizResult{Indicator: result.Indicator(), Mind: newMind(mbti.NewMind(p.WithModel(s.model)))}
+	for _, score := range result.Scores {
+		resp.Scores = append(resp.Scores, &Score{
+			Dichotomy: Dichotomy(score.Dichotomy),
+			First:     int32(score.First),
+			Second:    int32(score.Second),
+		})
+	}
+
+	return resp, nil
+}
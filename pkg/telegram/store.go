@@ -0,0 +1,63 @@
+package telegram
+
+import (
+	"sync"
+
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// Session is the progress of a quiz in a chat.
+type Session struct {
+	Answers []quiz.Answer `json:"answers"`
+}
+
+// SessionStore persists quiz sessions by chat ID. Implementations must be
+// safe for concurrent use.
+type SessionStore interface {
+	// Get returns the session of the given chat and whether it exists.
+	Get(chatID int64) (*Session, bool, error)
+	Put(chatID int64, s *Session) error
+	Delete(chatID int64) error
+}
+
+type memoryStore struct {
+	sessions map[int64]Session
+	mu       sync.Mutex
+}
+
+// NewMemoryStore creates a SessionStore that keeps sessions in memory.
+func NewMemoryStore() SessionStore {
+	return &memoryStore{sessions: make(map[int64]Session)}
+}
+
+func (m *memoryStore) Get(chatID int64) (*Session, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.sessions[chatID]
+	if !ok {
+		return nil, false, nil
+	}
+
+	s.Answers = append([]quiz.Answer(nil), s.Answers...)
+
+	return &s, true, nil
+}
+
+func (m *memoryStore) Put(chatID int64, s *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sessions[chatID] = Session{Answers: append([]quiz.Answer(nil), s.Answers...)}
+
+	return nil
+}
+
+func (m *memoryStore) Delete(chatID int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.sessions, chatID)
+
+	return nil
+}
@@ -0,0 +1,256 @@
+/*
+Package telegram adapts Telegram bot updates to the mbti library.
+
+A Bot turns each received Update into the messages that must be sent
+back, which the caller delivers through the Bot API's sendMessage method
+using any HTTP client or Telegram library:
+
+	bot := telegram.NewBot(telegram.NewMemoryStore(), quiz.DefaultQuestions)
+
+	for _, u := range updates {
+		msgs, err := bot.Handle(&u)
+		// send msgs...
+	}
+
+The bot understands the commands /mbti <type>, /quiz and /cancel.
+Quiz progress is kept per chat in a pluggable SessionStore.
+*/
+package telegram
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tmaxmax/mbti"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// Chat is the chat a message belongs to.
+type Chat struct {
+	ID int64 `json:"id"`
+}
+
+// Message is a message received by the bot.
+type Message struct {
+	MessageID int64  `json:"message_id"`
+	Chat      Chat   `json:"chat"`
+	Text      string `json:"text"`
+}
+
+// Update is an incoming update, as returned by getUpdates or sent to a webhook.
+type Update struct {
+	UpdateID int64    `json:"update_id"`
+	Message  *Message `json:"message,omitempty"`
+}
+
+// KeyboardButton is a button of a reply keyboard.
+type KeyboardButton struct {
+	Text string `json:"text"`
+}
+
+// ReplyMarkup is either a custom reply keyboard or a request to remove it.
+type ReplyMarkup struct {
+	Keyboard        [][]KeyboardButton `json:"keyboard,omitempty"`
+	OneTimeKeyboard bool               `json:"one_time_keyboard,omitempty"`
+	ResizeKeyboard  bool               `json:"resize_keyboard,omitempty"`
+	RemoveKeyboard  bool               `json:"remove_keyboard,omitempty"`
+}
+
+// SendMessage holds the parameters of a sendMessage call.
+type SendMessage struct {
+	ChatID      int64        `json:"chat_id"`
+	Text        string       `json:"text"`
+	ReplyMarkup *ReplyMarkup `json:"reply_markup,omitempty"`
+}
+
+const help = `Send me a Myers-Briggs type indicator (e.g. INFJ) or a pair of dominant functions (e.g. NiFe) and I'll show you its cognitive functions.
+
+/mbti <type> - show a personality type
+/quiz - find out your type
+/cancel - stop the quiz`
+
+// Bot handles updates for a single Telegram bot.
+type Bot struct {
+	store     SessionStore
+	questions []quiz.Question
+}
+
+// NewBot creates a bot that keeps quiz sessions in the given store
+// and asks the given questions.
+func NewBot(store SessionStore, questions []quiz.Question) *Bot {
+	return &Bot{store: store, questions: questions}
+}
+
+// Handle returns the messages to send in response to the given update.
+func (b *Bot) Handle(u *Update) ([]SendMessage, error) {
+	if u.Message == nil {
+		return nil, nil
+	}
+
+	chatID := u.Message.Chat.ID
+	command, arg := splitCommand(u.Message.Text)
+
+	switch command {
+	case "/start", "/help":
+		return reply(chatID, help), nil
+	case "/mbti":
+		return reply(chatID, describe(arg)), nil
+	case "/quiz":
+		return b.startQuiz(chatID)
+	case "/cancel":
+		if err := b.store.Delete(chatID); err != nil {
+			return nil, err
+		}
+
+		return []SendMessage{{ChatID: chatID, Text: "Quiz canceled.", ReplyMarkup: &ReplyMarkup{RemoveKeyboard: true}}}, nil
+	}
+
+	s, ok, err := b.store.Get(chatID)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		return b.answer(chatID, s, u.Message.Text)
+	}
+
+	return reply(chatID, describe(u.Message.Text)), nil
+}
+
+// splitCommand separates a leading bot command from its argument.
+// Commands addressed to a specific bot (/mbti@SomeBot) are supported.
+func splitCommand(text string) (command, arg string) {
+	text = strings.TrimSpace(text)
+	if !strings.HasPrefix(text, "/") {
+		return "", text
+	}
+
+	fields := strings.SplitN(text, " ", 2)
+	command = strings.SplitN(fields[0], "@", 2)[0]
+
+	if len(fields) > 1 {
+		arg = strings.TrimSpace(fields[1])
+	}
+
+	return command, arg
+}
+
+func reply(chatID int64, text string) []SendMessage {
+	return []SendMessage{{ChatID: chatID, Text: text}}
+}
+
+func formatMind(p *mbti.Personality) string {
+	m := mbti.NewMind(p)
+
+	return fmt.Sprintf("Ego: %s (%s)\nUnconscious: %s (%s)\nSubconscious: %s (%s)\nSuper-ego: %s (%s)",
+		m.Ego, mbti.JoinFunctions(m.Ego.Functions(), " "),
+		m.Unconscious, mbti.JoinFunctions(m.Unconscious.Functions(), " "),
+		m.Subconscious, mbti.JoinFunctions(m.Subconscious.Functions(), " "),
+		m.SuperEgo, mbti.JoinFunctions(m.SuperEgo.Functions(), " "),
+	)
+}
+
+func describe(input string) string {
+	p, err := mbti.Parse(input)
+	if err != nil {
+		return "I didn't understand that. " + help
+	}
+
+	return formatMind(p)
+}
+
+func (b *Bot) startQuiz(chatID int64) ([]SendMessage, error) {
+	if len(b.questions) == 0 {
+		return reply(chatID, "There are no quiz questions available."), nil
+	}
+
+	s := &Session{}
+	if err := b.store.Put(chatID, s); err != nil {
+		return nil, err
+	}
+
+	return []SendMessage{b.question(chatID, s)}, nil
+}
+
+func (b *Bot) question(chatID int64, s *Session) SendMessage {
+	i := len(s.Answers)
+	q := b.questions[i]
+
+	return SendMessage{
+		ChatID: chatID,
+		Text:   fmt.Sprintf("%d/%d. %s...", i+1, len(b.questions), q.Text),
+		ReplyMarkup: &ReplyMarkup{
+			Keyboard:        [][]KeyboardButton{{{Text: q.Choices[0]}}, {{Text: q.Choices[1]}}},
+			OneTimeKeyboard: true,
+			ResizeKeyboard:  true,
+		},
+	}
+}
+
+// choice finds the choice the text refers to, either by its text or by its number.
+func choice(q *quiz.Question, text string) (int, bool) {
+	text = strings.TrimSpace(text)
+
+	for i, c := range q.Choices {
+		if strings.EqualFold(text, c) || text == strconv.Itoa(i+1) {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func (b *Bot) answer(chatID int64, s *Session, text string) ([]SendMessage, error) {
+	// The stored session has no question left to answer, for example because
+	// fewer questions are asked since it started, so the quiz starts over.
+	if len(s.Answers) >= len(b.questions) {
+		if err := b.store.Delete(chatID); err != nil {
+			return nil, err
+		}
+
+		msgs, err := b.startQuiz(chatID)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(reply(chatID, "The quiz has changed, so it starts over."), msgs...), nil
+	}
+
+	q := &b.questions[len(s.Answers)]
+
+	c, ok := choice(q, text)
+	if !ok {
+		return []SendMessage{{ChatID: chatID, Text: "Please pick one of the two answers."}, b.question(chatID, s)}, nil
+	}
+
+	s.Answers = append(s.Answers, quiz.Answer{QuestionID: q.ID, Choice: c})
+
+	if len(s.Answers) < len(b.questions) {
+		if err := b.store.Put(chatID, s); err != nil {
+			return nil, err
+		}
+
+		return []SendMessage{b.question(chatID, s)}, nil
+	}
+
+	if err := b.store.Delete(chatID); err != nil {
+		return nil, err
+	}
+
+	result, err := quiz.Evaluate(b.questions, s.Answers)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := result.Personality()
+	if err != nil {
+		return nil, err
+	}
+
+	return []SendMessage{{
+		ChatID:      chatID,
+		Text:        fmt.Sprintf("Your type is %s.\n\n%s", p, formatMind(p)),
+		ReplyMarkup: &ReplyMarkup{RemoveKeyboard: true},
+	}}, nil
+}
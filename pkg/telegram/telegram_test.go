@@ -0,0 +1,38 @@
+package telegram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+func TestAnswerStaleSession(t *testing.T) {
+	store := NewMemoryStore()
+	questions := quiz.DefaultQuestions[:2]
+	bot := NewBot(store, questions)
+
+	// A session started when more questions were asked.
+	stale := &Session{}
+	for _, q := range quiz.DefaultQuestions[:3] {
+		stale.Answers = append(stale.Answers, quiz.Answer{QuestionID: q.ID})
+	}
+
+	if err := store.Put(1, stale); err != nil {
+		t.Fatal(err)
+	}
+
+	msgs, err := bot.Handle(&Update{Message: &Message{Chat: Chat{ID: 1}, Text: "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(msgs) != 2 || !strings.HasPrefix(msgs[1].Text, "1/2.") {
+		t.Fatalf("got %+v, want the quiz to start over", msgs)
+	}
+
+	s, ok, err := store.Get(1)
+	if err != nil || !ok || len(s.Answers) != 0 {
+		t.Fatalf("got session %+v, %t, %v, want a new one", s, ok, err)
+	}
+}
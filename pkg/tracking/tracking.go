@@ -0,0 +1,210 @@
+/*
+Package tracking records the personality types a person got over time,
+from quizzes or self-reports, and measures how stable they are.
+
+	store := tracking.NewFileStore("tracking.json")
+
+	err := store.Append(tracking.Entry{Time: time.Now(), Source: tracking.SourceSelfReport, Indicator: "INTJ"})
+
+	entries, err := store.Load()
+	metrics := tracking.Analyze(entries)
+
+Store is an interface, so entries can also be kept in a database.
+*/
+package tracking
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tmaxmax/mbti/internal/atomicfile"
+	"github.com/tmaxmax/mbti/quiz"
+)
+
+// Source is where a recorded type comes from.
+type Source string
+
+const (
+	SourceQuiz       Source = "quiz"
+	SourceSelfReport Source = "self-report"
+)
+
+// Entry is a type recorded at some point in time. Scores are set for quiz results only.
+type Entry struct {
+	Time      time.Time      `json:"time"`
+	Source    Source         `json:"source"`
+	Indicator string         `json:"indicator"`
+	Scores    *[4]quiz.Score `json:"scores,omitempty"`
+	Note      string         `json:"note,omitempty"`
+}
+
+// Store keeps the recorded entries.
+type Store interface {
+	// Load returns all entries, oldest first.
+	Load() ([]Entry, error)
+	Append(e Entry) error
+}
+
+// FileStore keeps the entries in a JSON file. Appending replaces the file
+// atomically, so a crash can't lose the recorded entries, and processes
+// appending to the same file wait for each other using a lock file next
+// to it, where the platform supports it.
+type FileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileStore creates a store keeping the entries in the file at path,
+// which is created on the first Append.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+// Load returns all entries, oldest first. There are none if the file doesn't exist.
+func (f *FileStore) Load() ([]Entry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.load()
+}
+
+func (f *FileStore) load() ([]Entry, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("invalid tracking file %s: %w", f.path, err)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.Before(entries[j].Time)
+	})
+
+	return entries, nil
+}
+
+// Append adds the entry to the file.
+func (f *FileStore) Append(e Entry) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	dir := filepath.Dir(f.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	lock, err := os.OpenFile(f.path+".lock", os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return err
+	}
+	defer lock.Close()
+
+	if err := lockFile(lock); err != nil {
+		return err
+	}
+
+	entries, err := f.load()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(append(entries, e), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.WriteFile(f.path, append(data, '\n'), 0o644)
+}
+
+// Metrics describe how stable the recorded types are over time.
+type Metrics struct {
+	Entries int
+	First   time.Time
+	Last    time.Time
+	// Modal is the most frequently recorded type, the most recent one on ties.
+	Modal string
+	// Stability is the fraction of entries recording the modal type.
+	Stability float64
+	// LetterStability is, for each letter of the indicator, the fraction of
+	// entries agreeing with the modal type's letter.
+	LetterStability [4]float64
+	// Changes is the number of times the type changed from one entry to the next.
+	Changes int
+	// Drift is, for each dichotomy, how much the balance of the quiz scores
+	// moved from the first quiz to the last one, from -2 to 2. Positive values
+	// move towards the first pole, such as E for extraversion/introversion.
+	Drift [4]float64
+}
+
+// Analyze computes the stability and drift metrics of the entries, which must be sorted by time.
+func Analyze(entries []Entry) Metrics {
+	m := Metrics{Entries: len(entries)}
+	if len(entries) == 0 {
+		return m
+	}
+
+	m.First, m.Last = entries[0].Time, entries[len(entries)-1].Time
+
+	counts := make(map[string]int)
+
+	for i, e := range entries {
+		counts[e.Indicator]++
+
+		if i > 0 && e.Indicator != entries[i-1].Indicator {
+			m.Changes++
+		}
+	}
+
+	for i := len(entries) - 1; i >= 0; i-- {
+		if indicator := entries[i].Indicator; counts[indicator] > counts[m.Modal] {
+			m.Modal = indicator
+		}
+	}
+
+	m.Stability = float64(counts[m.Modal]) / float64(len(entries))
+
+	for _, e := range entries {
+		for i := 0; i < len(m.LetterStability) && i < len(e.Indicator) && i < len(m.Modal); i++ {
+			if e.Indicator[i] == m.Modal[i] {
+				m.LetterStability[i]++
+			}
+		}
+	}
+
+	for i := range m.LetterStability {
+		m.LetterStability[i] /= float64(len(entries))
+	}
+
+	var first, last *[4]quiz.Score
+
+	for _, e := range entries {
+		if e.Scores == nil {
+			continue
+		}
+
+		if first == nil {
+			first = e.Scores
+		}
+
+		last = e.Scores
+	}
+
+	if first != nil {
+		for i := range m.Drift {
+			m.Drift[i] = last[i].Balance() - first[i].Balance()
+		}
+	}
+
+	return m
+}
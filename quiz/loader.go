@@ -0,0 +1,147 @@
+package quiz
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrUnknownDichotomy = errors.New("unknown dichotomy")
+
+// ParseDichotomy parses a dichotomy from its name ("attitude") or from its
+// poles ("EI" or "E/I"), ignoring case.
+func ParseDichotomy(s string) (Dichotomy, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(s), "/", ""))
+
+	for _, d := range Dichotomies {
+		first, second, _ := d.Poles()
+
+		if strings.EqualFold(normalized, d.String()) || normalized == string([]rune{first, second}) {
+			return d, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w %q", ErrUnknownDichotomy, s)
+}
+
+type questionFile struct {
+	ID        string   `yaml:"id"`
+	Text      string   `yaml:"text"`
+	Dichotomy string   `yaml:"dichotomy"`
+	Choices   []string `yaml:"choices"`
+}
+
+// LoadQuestions reads a question bank written in YAML (or JSON). The bank is
+// a list of questions, each with an id, a text, a dichotomy given as accepted
+// by ParseDichotomy and a list of exactly two choices. The questions should
+// be checked with Validate before being used.
+func LoadQuestions(r io.Reader) ([]Question, error) {
+	var file []questionFile
+	if err := yaml.NewDecoder(r).Decode(&file); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	questions := make([]Question, 0, len(file))
+
+	for i, q := range file {
+		d, err := ParseDichotomy(q.Dichotomy)
+		if err != nil {
+			return nil, fmt.Errorf("question %d (%q): %w", i+1, q.ID, err)
+		}
+
+		if len(q.Choices) != 2 {
+			return nil, fmt.Errorf("question %d (%q): %w: expected 2 choices, got %d", i+1, q.ID, ErrInvalidChoice, len(q.Choices))
+		}
+
+		questions = append(questions, Question{ID: q.ID, Text: q.Text, Dichotomy: d, Choices: [2]string{q.Choices[0], q.Choices[1]}})
+	}
+
+	return questions, nil
+}
+
+// Problem is an issue found in a question bank.
+type Problem struct {
+	// QuestionID is the ID of the question with the problem, or empty for
+	// problems concerning the whole bank.
+	QuestionID string
+	Message    string
+	// Fatal problems make the question bank unusable.
+	Fatal bool
+}
+
+func (p Problem) String() string {
+	if p.QuestionID == "" {
+		return p.Message
+	}
+
+	return fmt.Sprintf("question %q: %s", p.QuestionID, p.Message)
+}
+
+// Validate checks a question bank for malformed questions and coverage
+// problems: every dichotomy must be measured, and should be measured by an
+// odd number of questions so that it can't end in a tie, as well as by
+// roughly as many questions as the others.
+func Validate(questions []Question) []Problem {
+	var problems []Problem
+
+	seen := make(map[string]bool, len(questions))
+	var counts [len(Dichotomies)]int
+
+	for _, q := range questions {
+		switch {
+		case q.ID == "":
+			problems = append(problems, Problem{Message: fmt.Sprintf("question %q has no ID", q.Text), Fatal: true})
+		case seen[q.ID]:
+			problems = append(problems, Problem{QuestionID: q.ID, Message: "duplicate ID", Fatal: true})
+		}
+
+		seen[q.ID] = true
+
+		if strings.TrimSpace(q.Text) == "" {
+			problems = append(problems, Problem{QuestionID: q.ID, Message: "empty text", Fatal: true})
+		}
+
+		if strings.TrimSpace(q.Choices[0]) == "" || strings.TrimSpace(q.Choices[1]) == "" {
+			problems = append(problems, Problem{QuestionID: q.ID, Message: "empty choice", Fatal: true})
+		}
+
+		if !q.Dichotomy.valid() {
+			problems = append(problems, Problem{QuestionID: q.ID, Message: fmt.Sprintf("invalid dichotomy %d", int(q.Dichotomy)), Fatal: true})
+
+			continue
+		}
+
+		counts[q.Dichotomy]++
+	}
+
+	least, most := counts[0], counts[0]
+
+	for _, d := range Dichotomies {
+		n := counts[d]
+		first, second, _ := d.Poles()
+
+		switch {
+		case n == 0:
+			problems = append(problems, Problem{Message: fmt.Sprintf("no questions measure the %s dichotomy (%c/%c)", d, first, second), Fatal: true})
+		case n%2 == 0:
+			problems = append(problems, Problem{Message: fmt.Sprintf("the %s dichotomy (%c/%c) is measured by an even number of questions (%d), so it can end in a tie", d, first, second, n)})
+		}
+
+		if n < least {
+			least = n
+		}
+
+		if n > most {
+			most = n
+		}
+	}
+
+	if least > 0 && most > 2*least {
+		problems = append(problems, Problem{Message: fmt.Sprintf("the dichotomies are unevenly covered, by %d to %d questions", least, most)})
+	}
+
+	return problems
+}
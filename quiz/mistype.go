@@ -0,0 +1,170 @@
+package quiz
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// maxMistypeQuestions is the number of questions asked to check a claimed type.
+const maxMistypeQuestions = 6
+
+// functionStatements describe each function in the first person.
+var functionStatements = map[mbti.Function]string{
+	mbti.Ni: "I follow a single inner vision of where things are heading",
+	mbti.Ne: "I love exploring many possibilities and connecting unrelated ideas",
+	mbti.Si: "I rely on what has worked before and remember details of past experiences",
+	mbti.Se: "I react to what is happening right now and enjoy hands-on action",
+	mbti.Ti: "I need things to make logical sense by my own standards",
+	mbti.Te: "I organize people and tasks to get measurable results",
+	mbti.Fi: "I decide by what feels right according to my personal values",
+	mbti.Fe: "I decide by what keeps the people around me in harmony",
+}
+
+// FunctionQuestion asks which of two functions describes the respondent better.
+// Choosing Choices[i] favors Functions[i].
+type FunctionQuestion struct {
+	ID        string           `json:"id"`
+	Text      string           `json:"text"`
+	Functions [2]mbti.Function `json:"-"`
+	Choices   [2]string        `json:"choices"`
+}
+
+// MistypeQuestions returns a handful of questions telling the claimed type apart from
+// the types commonly confused with it. Each question contrasts two functions whose
+// order differs between the claimed type and an alternative, most telling first.
+func MistypeQuestions(claimed *mbti.Personality) []FunctionQuestion {
+	var (
+		ret  []FunctionQuestion
+		seen = make(map[[2]mbti.Function]bool)
+	)
+
+	add := func(a, b mbti.Function) {
+		if a == b || seen[[2]mbti.Function{a, b}] || seen[[2]mbti.Function{b, a}] || len(ret) == maxMistypeQuestions {
+			return
+		}
+
+		seen[[2]mbti.Function{a, b}] = true
+		ret = append(ret, FunctionQuestion{
+			ID:        fmt.Sprintf("%s-%s", a, b),
+			Text:      "Which describes you better?",
+			Functions: [2]mbti.Function{a, b},
+			Choices:   [2]string{functionStatements[a], functionStatements[b]},
+		})
+	}
+
+	alternatives := mbti.ConfusedWith(claimed)
+	claimedFunctions := claimed.Functions()
+
+	// Contrast the leading functions first, as they differ the most in behavior.
+	for _, alt := range alternatives {
+		altFunctions := alt.Functions()
+		add(claimedFunctions[mbti.PositionDominant], altFunctions[mbti.PositionDominant])
+	}
+
+	for _, alt := range alternatives {
+		altFunctions := alt.Functions()
+		add(claimedFunctions[mbti.PositionAuxiliary], altFunctions[mbti.PositionAuxiliary])
+		add(claimedFunctions[mbti.PositionDominant], claimedFunctions[mbti.PositionAuxiliary])
+	}
+
+	return ret
+}
+
+// Likelihoods of an answer given a candidate type, depending on whether the
+// type prefers the chosen function over the other one.
+const (
+	likelihoodConsistent   = 0.8
+	likelihoodInconsistent = 0.2
+	likelihoodNeutral      = 0.5
+)
+
+// Candidate is a type together with its estimated probability.
+type Candidate struct {
+	Personality *mbti.Personality
+	Probability float64
+}
+
+// MistypeEstimate is the result of EstimateMistype.
+type MistypeEstimate struct {
+	Claimed *mbti.Personality
+	// Probability is the estimated probability that the claimed type is wrong.
+	Probability float64
+	// Alternatives are the likely actual types, most probable first.
+	Alternatives []Candidate
+}
+
+// EstimateMistype estimates whether the claimed type is wrong from the answers to
+// the questions returned by MistypeQuestions. The claimed type and the types
+// confused with it start out equally likely as a whole; each answer favors the
+// types that rank the chosen function above the other one.
+func EstimateMistype(claimed *mbti.Personality, questions []FunctionQuestion, answers []Answer) (*MistypeEstimate, error) {
+	byID := make(map[string]*FunctionQuestion, len(questions))
+	for i := range questions {
+		byID[questions[i].ID] = &questions[i]
+	}
+
+	alternatives := mbti.ConfusedWith(claimed)
+	candidates := []Candidate{{Personality: claimed, Probability: 0.5}}
+
+	for _, alt := range alternatives {
+		candidates = append(candidates, Candidate{Personality: alt, Probability: 0.5 / float64(len(alternatives))})
+	}
+
+	for _, a := range answers {
+		q, ok := byID[a.QuestionID]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownQuestion, a.QuestionID)
+		}
+
+		if a.Choice != 0 && a.Choice != 1 {
+			return nil, fmt.Errorf("%w %d for question %q", ErrInvalidChoice, a.Choice, a.QuestionID)
+		}
+
+		chosen, other := q.Functions[a.Choice], q.Functions[1-a.Choice]
+
+		var total float64
+		for i := range candidates {
+			candidates[i].Probability *= likelihood(candidates[i].Personality, chosen, other)
+			total += candidates[i].Probability
+		}
+
+		for i := range candidates {
+			candidates[i].Probability /= total
+		}
+	}
+
+	e := &MistypeEstimate{Claimed: claimed, Probability: 1 - candidates[0].Probability, Alternatives: candidates[1:]}
+
+	sort.SliceStable(e.Alternatives, func(i, j int) bool {
+		return e.Alternatives[i].Probability > e.Alternatives[j].Probability
+	})
+
+	return e, nil
+}
+
+// likelihood returns how likely a person of the given type is to prefer the chosen function over the other one.
+func likelihood(p *mbti.Personality, chosen, other mbti.Function) float64 {
+	chosenPosition, otherPosition := stackPosition(p, chosen), stackPosition(p, other)
+
+	switch {
+	case chosenPosition < otherPosition:
+		return likelihoodConsistent
+	case chosenPosition > otherPosition:
+		return likelihoodInconsistent
+	default:
+		return likelihoodNeutral
+	}
+}
+
+// stackPosition returns the position of the function in the stack, or 4 if it isn't part of it.
+func stackPosition(p *mbti.Personality, fn mbti.Function) int {
+	for i, f := range p.Functions() {
+		if f == fn {
+			return i
+		}
+	}
+
+	return len(p.Functions())
+}
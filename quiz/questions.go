@@ -0,0 +1,28 @@
+package quiz
+
+// DefaultQuestions is the built-in question bank.
+var DefaultQuestions = []Question{
+	{ID: "ei1", Dichotomy: Attitude, Text: "After a long week, you recharge by", Choices: [2]string{"going out with friends", "spending time alone"}},
+	{ID: "ei2", Dichotomy: Attitude, Text: "In a group discussion you usually", Choices: [2]string{"think out loud", "think before speaking"}},
+	{ID: "ei3", Dichotomy: Attitude, Text: "You would rather have", Choices: [2]string{"many acquaintances", "a few close friends"}},
+	{ID: "ei4", Dichotomy: Attitude, Text: "When meeting new people you feel", Choices: [2]string{"energized", "drained"}},
+	{ID: "ei5", Dichotomy: Attitude, Text: "You prefer to work", Choices: [2]string{"with others", "on your own"}},
+
+	{ID: "sn1", Dichotomy: Perception, Text: "You trust more", Choices: [2]string{"experience", "intuition"}},
+	{ID: "sn2", Dichotomy: Perception, Text: "You are more interested in", Choices: [2]string{"what is real", "what is possible"}},
+	{ID: "sn3", Dichotomy: Perception, Text: "When learning something new you prefer", Choices: [2]string{"concrete examples", "the underlying theory"}},
+	{ID: "sn4", Dichotomy: Perception, Text: "You tend to notice", Choices: [2]string{"details", "patterns"}},
+	{ID: "sn5", Dichotomy: Perception, Text: "Instructions should be", Choices: [2]string{"precise and step by step", "broad, leaving room to improvise"}},
+
+	{ID: "tf1", Dichotomy: Judgement, Text: "When making a decision you rely on", Choices: [2]string{"logic", "values"}},
+	{ID: "tf2", Dichotomy: Judgement, Text: "It is worse to be", Choices: [2]string{"unfair", "unkind"}},
+	{ID: "tf3", Dichotomy: Judgement, Text: "You are more convinced by", Choices: [2]string{"a solid argument", "a moving story"}},
+	{ID: "tf4", Dichotomy: Judgement, Text: "When a friend is upset you first", Choices: [2]string{"try to solve the problem", "try to comfort them"}},
+	{ID: "tf5", Dichotomy: Judgement, Text: "You would rather be seen as", Choices: [2]string{"competent", "caring"}},
+
+	{ID: "jp1", Dichotomy: Lifestyle, Text: "You prefer to", Choices: [2]string{"plan ahead", "go with the flow"}},
+	{ID: "jp2", Dichotomy: Lifestyle, Text: "Deadlines are", Choices: [2]string{"to be met early", "a suggestion"}},
+	{ID: "jp3", Dichotomy: Lifestyle, Text: "Your workspace is usually", Choices: [2]string{"tidy", "creatively messy"}},
+	{ID: "jp4", Dichotomy: Lifestyle, Text: "You feel better when things are", Choices: [2]string{"settled", "open"}},
+	{ID: "jp5", Dichotomy: Lifestyle, Text: "On vacation you", Choices: [2]string{"follow an itinerary", "decide as you go"}},
+}
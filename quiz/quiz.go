@@ -0,0 +1,300 @@
+/*
+Package quiz implements a forced-choice questionnaire that
+determines a Myers-Briggs personality type.
+
+Each question measures one of the four dichotomies and offers two
+choices, each one favoring one of the dichotomy's poles. Evaluating a
+set of answers tallies the choices per dichotomy and picks the
+preferred pole of each.
+
+The package can also check a claimed type: MistypeQuestions contrasts the
+functions of the type with those of the types commonly confused with it,
+and EstimateMistype weighs the answers into the probability of a mistype.
+*/
+package quiz
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/tmaxmax/mbti"
+)
+
+// Dichotomy is one of the four preference pairs measured by the questionnaire.
+type Dichotomy int
+
+const (
+	// Attitude is the Extraversion/Introversion dichotomy.
+	Attitude Dichotomy = iota
+	// Perception is the Sensation/Intuition dichotomy.
+	Perception
+	// Judgement is the Thinking/Feeling dichotomy.
+	Judgement
+	// Lifestyle is the Judging/Perceiving dichotomy.
+	Lifestyle
+)
+
+// Dichotomies lists all dichotomies in indicator order.
+var Dichotomies = [...]Dichotomy{Attitude, Perception, Judgement, Lifestyle}
+
+var dichotomyPoles = [...][2]rune{
+	Attitude:   {'E', 'I'},
+	Perception: {'S', 'N'},
+	Judgement:  {'T', 'F'},
+	Lifestyle:  {'J', 'P'},
+}
+
+var dichotomyNames = [...]string{
+	Attitude:   "attitude",
+	Perception: "perception",
+	Judgement:  "judgement",
+	Lifestyle:  "lifestyle",
+}
+
+// Poles returns the indicator letters of the dichotomy's two poles.
+func (d Dichotomy) Poles() (first, second rune, err error) {
+	if !d.valid() {
+		return 0, 0, fmt.Errorf("%w %d", ErrUnknownDichotomy, int(d))
+	}
+
+	return dichotomyPoles[d][0], dichotomyPoles[d][1], nil
+}
+
+func (d Dichotomy) valid() bool {
+	return d >= Attitude && d <= Lifestyle
+}
+
+func (d Dichotomy) String() string {
+	if !d.valid() {
+		return fmt.Sprintf("Dichotomy(%d)", int(d))
+	}
+
+	return dichotomyNames[d]
+}
+
+// MarshalText encodes the dichotomy as the letters of its poles, such as "EI".
+func (d Dichotomy) MarshalText() ([]byte, error) {
+	first, second, err := d.Poles()
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(string([]rune{first, second})), nil
+}
+
+// UnmarshalText decodes any form accepted by ParseDichotomy.
+func (d *Dichotomy) UnmarshalText(text []byte) error {
+	parsed, err := ParseDichotomy(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = parsed
+
+	return nil
+}
+
+// UnmarshalJSON decodes the text form, or the number the dichotomy was
+// encoded as in earlier versions, such as in saved quiz results.
+func (d *Dichotomy) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		if n < int(Attitude) || n > int(Lifestyle) {
+			return fmt.Errorf("%w %d", ErrUnknownDichotomy, n)
+		}
+
+		*d = Dichotomy(n)
+
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return d.UnmarshalText([]byte(s))
+}
+
+// Question is a forced-choice question. Choosing Choices[0] favors
+// the first pole of the dichotomy, Choices[1] favors the second.
+type Question struct {
+	ID        string    `json:"id"`
+	Text      string    `json:"text"`
+	Dichotomy Dichotomy `json:"dichotomy"`
+	Choices   [2]string `json:"choices"`
+}
+
+// Answer is the choice given to the question with the given ID.
+type Answer struct {
+	QuestionID string `json:"questionId"`
+	Choice     int    `json:"choice"`
+}
+
+// Score is the tally of answers for a single dichotomy.
+type Score struct {
+	Dichotomy Dichotomy `json:"dichotomy"`
+	First     int       `json:"first"`
+	Second    int       `json:"second"`
+}
+
+// Preference returns the letter of the preferred pole. Ties are broken
+// in favor of the second pole (I, N, F, P), as is customary. It returns 0
+// if the dichotomy is unknown.
+func (s Score) Preference() rune {
+	first, second, _ := s.Dichotomy.Poles()
+	if s.First > s.Second {
+		return first
+	}
+
+	return second
+}
+
+// Strength returns how clear the preference is, from 0 (tie or no answers)
+// to 1 (all answers favor the same pole).
+func (s Score) Strength() float64 {
+	total := s.First + s.Second
+	if total == 0 {
+		return 0
+	}
+
+	diff := s.First - s.Second
+	if diff < 0 {
+		diff = -diff
+	}
+
+	return float64(diff) / float64(total)
+}
+
+// Balance returns the preference as a number between -1 and 1, where
+// positive values favor the first pole and negative ones the second.
+func (s Score) Balance() float64 {
+	total := s.First + s.Second
+	if total == 0 {
+		return 0
+	}
+
+	return float64(s.First-s.Second) / float64(total)
+}
+
+// Result is the outcome of evaluating a set of answers.
+type Result struct {
+	Scores [4]Score `json:"scores"`
+}
+
+// Indicator returns the four letter type indicator of the result.
+func (r *Result) Indicator() string {
+	letters := make([]rune, 0, len(r.Scores))
+	for _, s := range r.Scores {
+		letters = append(letters, s.Preference())
+	}
+
+	return string(letters)
+}
+
+// Personality returns the personality type determined by the result.
+func (r *Result) Personality() (*mbti.Personality, error) {
+	return mbti.FromIndicator(r.Indicator())
+}
+
+var (
+	ErrUnknownQuestion = errors.New("unknown question")
+	ErrInvalidChoice   = errors.New("invalid choice")
+	ErrDuplicateAnswer = errors.New("question answered more than once")
+)
+
+// EventQuizAnswered is published by Evaluate through mbti.Publish, with a QuizAnswered payload.
+const EventQuizAnswered = "quiz.answered"
+
+// QuizAnswered is the payload of EventQuizAnswered.
+type QuizAnswered struct {
+	Answers []Answer
+	Result  *Result
+}
+
+// Evaluate scores the answers against the given questions. Each question
+// can be answered at most once, and the answered questions must measure
+// a known dichotomy. Successful evaluations publish EventQuizAnswered.
+func Evaluate(questions []Question, answers []Answer) (*Result, error) {
+	byID := make(map[string]*Question, len(questions))
+	for i := range questions {
+		byID[questions[i].ID] = &questions[i]
+	}
+
+	r := &Result{}
+	for _, d := range Dichotomies {
+		r.Scores[d].Dichotomy = d
+	}
+
+	answered := make(map[string]bool, len(answers))
+
+	for _, a := range answers {
+		q, ok := byID[a.QuestionID]
+		if !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownQuestion, a.QuestionID)
+		}
+
+		if !q.Dichotomy.valid() {
+			return nil, fmt.Errorf("%w %d in question %q", ErrUnknownDichotomy, int(q.Dichotomy), q.ID)
+		}
+
+		if answered[a.QuestionID] {
+			return nil, fmt.Errorf("%w: %q", ErrDuplicateAnswer, a.QuestionID)
+		}
+
+		answered[a.QuestionID] = true
+
+		switch a.Choice {
+		case 0:
+			r.Scores[q.Dichotomy].First++
+		case 1:
+			r.Scores[q.Dichotomy].Second++
+		default:
+			return nil, fmt.Errorf("%w %d for question %q", ErrInvalidChoice, a.Choice, a.QuestionID)
+		}
+	}
+
+	mbti.Publish(mbti.Event{Name: EventQuizAnswered, Payload: QuizAnswered{Answers: answers, Result: r}})
+
+	return r, nil
+}
+
+// borderlineStrength is the strength below which a preference is too weak
+// to be trusted: 0.4 means less than 70% of the answers favor the pole.
+const borderlineStrength = 0.4
+
+// Change is how the score of a dichotomy changed between two results.
+type Change struct {
+	Before Score `json:"before"`
+	After  Score `json:"after"`
+}
+
+// Flipped reports whether the preferred pole changed.
+func (c Change) Flipped() bool {
+	return c.Before.Preference() != c.After.Preference()
+}
+
+// StrengthDelta returns how much stronger the preference became, which is
+// negative if it weakened. The strengths of flipped preferences are compared
+// as they are, even though they favor different poles.
+func (c Change) StrengthDelta() float64 {
+	return c.After.Strength() - c.Before.Strength()
+}
+
+// LikelyMistype reports whether the preference flipped while being borderline
+// in at least one of the results, which hints at a mistype rather than a change.
+func (c Change) LikelyMistype() bool {
+	return c.Flipped() && (c.Before.Strength() < borderlineStrength || c.After.Strength() < borderlineStrength)
+}
+
+// Diff compares the scores of two results, dichotomy by dichotomy.
+func Diff(before, after *Result) [4]Change {
+	var changes [4]Change
+
+	for i := range changes {
+		changes[i] = Change{Before: before.Scores[i], After: after.Scores[i]}
+	}
+
+	return changes
+}
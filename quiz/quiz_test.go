@@ -0,0 +1,59 @@
+package quiz
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	var answers []Answer
+	for _, q := range DefaultQuestions {
+		answers = append(answers, Answer{QuestionID: q.ID, Choice: 0})
+	}
+
+	r, err := Evaluate(DefaultQuestions, answers)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := r.Indicator(); got != "ESTJ" {
+		t.Fatalf("got %s, want ESTJ", got)
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	id := DefaultQuestions[0].ID
+
+	tests := []struct {
+		name    string
+		answers []Answer
+		want    error
+	}{
+		{"unknown question", []Answer{{QuestionID: "nope"}}, ErrUnknownQuestion},
+		{"invalid choice", []Answer{{QuestionID: id, Choice: 2}}, ErrInvalidChoice},
+		{"duplicate answer", []Answer{{QuestionID: id}, {QuestionID: id}}, ErrDuplicateAnswer},
+		{"unknown dichotomy", []Answer{{QuestionID: "bad"}}, ErrUnknownDichotomy},
+	}
+
+	questions := append([]Question{{ID: "bad", Dichotomy: Lifestyle + 1}}, DefaultQuestions...)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Evaluate(questions, tt.answers); !errors.Is(err, tt.want) {
+				t.Fatalf("got error %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestPoles(t *testing.T) {
+	if first, second, err := Judgement.Poles(); err != nil || first != 'T' || second != 'F' {
+		t.Fatalf("got %c, %c, %v, want T, F, nil", first, second, err)
+	}
+
+	for _, d := range []Dichotomy{-1, Lifestyle + 1} {
+		if _, _, err := d.Poles(); !errors.Is(err, ErrUnknownDichotomy) {
+			t.Fatalf("%s: got error %v, want %v", d, err, ErrUnknownDichotomy)
+		}
+	}
+}
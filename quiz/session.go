@@ -0,0 +1,255 @@
+package quiz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+var (
+	ErrSessionNotFound   = errors.New("session not found")
+	ErrSessionComplete   = errors.New("all questions are answered")
+	ErrUnexpectedAnswer  = errors.New("answer is not for the next question")
+	ErrSessionIncomplete = errors.New("not all questions are answered")
+)
+
+// Session is a quiz taken one question at a time, in the order of the questions.
+type Session struct {
+	ID      string    `json:"id"`
+	Answers []Answer  `json:"answers"`
+	Created time.Time `json:"created"`
+	Updated time.Time `json:"updated"`
+}
+
+// NewSession starts a session with a random ID.
+func NewSession() (*Session, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+
+	return &Session{ID: hex.EncodeToString(id), Created: now, Updated: now}, nil
+}
+
+// Next returns the next question to answer, or false if all are answered.
+func (s *Session) Next(questions []Question) (Question, bool) {
+	if len(s.Answers) >= len(questions) {
+		return Question{}, false
+	}
+
+	return questions[len(s.Answers)], true
+}
+
+// Answer records the answer to the next question.
+func (s *Session) Answer(questions []Question, a Answer) error {
+	next, ok := s.Next(questions)
+	if !ok {
+		return ErrSessionComplete
+	}
+
+	if a.QuestionID != next.ID {
+		return fmt.Errorf("%w: expected %q, got %q", ErrUnexpectedAnswer, next.ID, a.QuestionID)
+	}
+
+	if a.Choice != 0 && a.Choice != 1 {
+		return fmt.Errorf("%w %d for question %q", ErrInvalidChoice, a.Choice, a.QuestionID)
+	}
+
+	s.Answers = append(s.Answers, a)
+	s.Updated = time.Now().UTC()
+
+	return nil
+}
+
+// Result evaluates the answers once all questions are answered.
+func (s *Session) Result(questions []Question) (*Result, error) {
+	if len(s.Answers) < len(questions) {
+		return nil, fmt.Errorf("%w: %d of %d", ErrSessionIncomplete, len(s.Answers), len(questions))
+	}
+
+	return Evaluate(questions, s.Answers)
+}
+
+// SessionStore persists sessions. Load returns ErrSessionNotFound for unknown IDs.
+type SessionStore interface {
+	Load(ctx context.Context, id string) (*Session, error)
+	Save(ctx context.Context, s *Session) error
+	Delete(ctx context.Context, id string) error
+}
+
+// MemoryStore keeps sessions in memory.
+type MemoryStore struct {
+	sessions map[string]Session
+	mu       sync.Mutex
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: make(map[string]Session)}
+}
+
+func (m *MemoryStore) Load(_ context.Context, id string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	s, ok := m.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	s.Answers = append([]Answer(nil), s.Answers...)
+
+	return &s, nil
+}
+
+func (m *MemoryStore) Save(_ context.Context, s *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c := *s
+	c.Answers = append([]Answer(nil), s.Answers...)
+	m.sessions[s.ID] = c
+
+	return nil
+}
+
+func (m *MemoryStore) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.sessions, id)
+
+	return nil
+}
+
+// FileStore keeps each session in a JSON file of a directory.
+type FileStore struct {
+	dir string
+}
+
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+func (f *FileStore) path(id string) (string, error) {
+	// IDs come from clients, so only the hexadecimal IDs created by NewSession are accepted.
+	if _, err := hex.DecodeString(id); err != nil || id == "" {
+		return "", ErrSessionNotFound
+	}
+
+	return filepath.Join(f.dir, id+".json"), nil
+}
+
+func (f *FileStore) Load(_ context.Context, id string) (*Session, error) {
+	path, err := f.path(id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrSessionNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	var s Session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (f *FileStore) Save(_ context.Context, s *Session) error {
+	path, err := f.path(s.ID)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first, so a crash never leaves a partial session.
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func (f *FileStore) Delete(_ context.Context, id string) error {
+	path, err := f.path(id)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// RedisClient is the subset of a Redis client used by RedisStore, so that
+// any client library can be adapted without this package depending on it.
+// Get must report whether the key exists instead of returning an error.
+type RedisClient interface {
+	Get(ctx context.Context, key string) (value string, ok bool, err error)
+	Set(ctx context.Context, key, value string, ttl time.Duration) error
+	Del(ctx context.Context, key string) error
+}
+
+// RedisStore keeps sessions in Redis, under the given key prefix. Sessions
+// expire after TTL without updates, unless TTL is 0.
+type RedisStore struct {
+	Client RedisClient
+	Prefix string
+	TTL    time.Duration
+}
+
+func (r *RedisStore) Load(ctx context.Context, id string) (*Session, error) {
+	value, ok, err := r.Client.Get(ctx, r.Prefix+id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	var s Session
+	if err := json.Unmarshal([]byte(value), &s); err != nil {
+		return nil, err
+	}
+
+	return &s, nil
+}
+
+func (r *RedisStore) Save(ctx context.Context, s *Session) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return r.Client.Set(ctx, r.Prefix+s.ID, string(data), r.TTL)
+}
+
+func (r *RedisStore) Delete(ctx context.Context, id string) error {
+	return r.Client.Del(ctx, r.Prefix+id)
+}
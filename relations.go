@@ -0,0 +1,161 @@
+package mbti
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RelationType is an intertype relation, as defined by socionics.
+// Relations are computed from the dominant and auxiliary functions of
+// the two personalities, so ENTP (Ne Ti) corresponds to the socionics ILE.
+type RelationType int
+
+const (
+	RelationIdentity RelationType = iota
+	RelationDuality
+	RelationActivation
+	RelationMirror
+	RelationKindred
+	RelationBusiness
+	RelationSemiDuality
+	RelationMirage
+	RelationSuperEgo
+	RelationConflict
+	RelationQuasiIdentity
+	RelationContrary
+	// RelationSupervisor is the relation of a personality towards its supervisee.
+	RelationSupervisor
+	// RelationSupervisee is the relation of a personality towards its supervisor.
+	RelationSupervisee
+	// RelationBenefactor is the relation of a personality towards its beneficiary.
+	RelationBenefactor
+	// RelationBeneficiary is the relation of a personality towards its benefactor.
+	RelationBeneficiary
+)
+
+// invert flips both the kind and the focus of the function: Ne becomes Si.
+func (f Function) invert() Function {
+	return Function{focus: invertFocus(f.focus), kind: invertKind(f.kind)}
+}
+
+// invertKind flips the kind of the function, keeping its axis and focus: Ne becomes Se.
+func (f Function) invertKind() Function {
+	return Function{focus: f.focus, kind: invertKind(f.kind)}
+}
+
+// relationPartners maps each relation to the dominant and auxiliary functions of
+// the partner of a personality with the given ones.
+var relationPartners = map[RelationType]func(dom, aux Function) (Function, Function){
+	RelationIdentity:      func(d, a Function) (Function, Function) { return d, a },
+	RelationDuality:       func(d, a Function) (Function, Function) { return d.invert(), a.invert() },
+	RelationActivation:    func(d, a Function) (Function, Function) { return a.invert(), d.invert() },
+	RelationMirror:        func(d, a Function) (Function, Function) { return a, d },
+	RelationKindred:       func(d, a Function) (Function, Function) { return d, a.invertKind() },
+	RelationBusiness:      func(d, a Function) (Function, Function) { return d.invertKind(), a },
+	RelationSemiDuality:   func(d, a Function) (Function, Function) { return d.invert(), a.invertFocus() },
+	RelationMirage:        func(d, a Function) (Function, Function) { return d.invertFocus(), a.invert() },
+	RelationSuperEgo:      func(d, a Function) (Function, Function) { return d.invertKind(), a.invertKind() },
+	RelationConflict:      func(d, a Function) (Function, Function) { return a.invertKind(), d.invertKind() },
+	RelationQuasiIdentity: func(d, a Function) (Function, Function) { return a.invertFocus(), d.invertFocus() },
+	RelationContrary:      func(d, a Function) (Function, Function) { return d.invertFocus(), a.invertFocus() },
+	// The supervisee's inferior function is the supervisor's dominant one.
+	RelationSupervisor: func(d, a Function) (Function, Function) { return a, d.invertKind() },
+	RelationSupervisee: func(d, a Function) (Function, Function) { return a.invertKind(), d },
+	RelationBenefactor: func(d, a Function) (Function, Function) { return a.invertFocus(), d.invert() },
+	// The benefactor's dominant function is the one the beneficiary is most eager to develop.
+	RelationBeneficiary: func(d, a Function) (Function, Function) { return a.invert(), d.invertFocus() },
+}
+
+// Relationship returns the relation personality a has towards personality b.
+// It publishes EventRelationComputed.
+func Relationship(a, b *Personality) RelationType {
+	r := relationship(a, b)
+	Publish(Event{Name: EventRelationComputed, Payload: RelationComputed{A: a, B: b, Relation: r}})
+
+	return r
+}
+
+func relationship(a, b *Personality) RelationType {
+	for r := RelationIdentity; r <= RelationBeneficiary; r++ {
+		if dom, aux := relationPartners[r](a.primary, a.auxiliary); dom == b.primary && aux == b.auxiliary {
+			return r
+		}
+	}
+
+	// Unreachable for valid personalities: the relations cover all 16 types.
+	panic(fmt.Sprintf("mbti: no relation between %s and %s", a, b))
+}
+
+// Partner returns the personality that is in the given relation with p,
+// as seen from p. For example, p.Partner(RelationSupervisor) returns the
+// personality p supervises.
+func (p *Personality) Partner(r RelationType) *Personality {
+	dom, aux := relationPartners[r](p.primary, p.auxiliary)
+
+	return withModel(dom, aux, p.Model())
+}
+
+// Symmetric reports whether the relation is the same when seen from both sides.
+func (r RelationType) Symmetric() bool {
+	switch r {
+	case RelationSupervisor, RelationSupervisee, RelationBenefactor, RelationBeneficiary:
+		return false
+	default:
+		return true
+	}
+}
+
+// Inverse returns the relation as seen from the other personality.
+func (r RelationType) Inverse() RelationType {
+	switch r {
+	case RelationSupervisor:
+		return RelationSupervisee
+	case RelationSupervisee:
+		return RelationSupervisor
+	case RelationBenefactor:
+		return RelationBeneficiary
+	case RelationBeneficiary:
+		return RelationBenefactor
+	default:
+		return r
+	}
+}
+
+// ErrUnknownRelation is returned by ParseRelation for unknown names.
+var ErrUnknownRelation = errors.New("unknown relation")
+
+// ParseRelation returns the relation with the given name, ignoring case,
+// spaces and hyphens, so both "Semi-duality" and "semiduality" are accepted.
+func ParseRelation(name string) (RelationType, error) {
+	normalize := strings.NewReplacer("-", "", " ", "", "_", "")
+	key := strings.ToLower(normalize.Replace(name))
+
+	for r := RelationIdentity; r <= RelationBeneficiary; r++ {
+		if strings.ToLower(normalize.Replace(r.String())) == key {
+			return r, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w %q", ErrUnknownRelation, name)
+}
+
+func (r RelationType) String() string {
+	if d, ok := relationDescriptions[r]; ok {
+		return d.Name
+	}
+
+	return fmt.Sprintf("RelationType(%d)", int(r))
+}
+
+type RelationDescription struct {
+	Name      string
+	Dynamics  string
+	Strengths []string
+	Friction  []string
+}
+
+// Description returns a description of the relation's dynamics.
+func (r RelationType) Description() RelationDescription {
+	return relationDescriptions[r]
+}
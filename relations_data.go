@@ -0,0 +1,195 @@
+package mbti
+
+var relationDescriptions = map[RelationType]RelationDescription{
+	RelationIdentity: {
+		Name:     "Identity",
+		Dynamics: "Both share the same way of seeing the world and understand each other's reasoning instantly, but they also share the same blind spots.",
+		Strengths: []string{
+			"Effortless mutual understanding",
+			"Shared interests and priorities",
+		},
+		Friction: []string{
+			"Neither can cover the other's weaknesses",
+			"Competition when both want the same role",
+		},
+	},
+	RelationDuality: {
+		Name:     "Duality",
+		Dynamics: "Each partner's strongest functions are exactly what the other needs most, so the relation feels supportive and relaxing over time.",
+		Strengths: []string{
+			"Complementary strengths and weaknesses",
+			"Psychological comfort and mutual support",
+			"Natural division of tasks",
+		},
+		Friction: []string{
+			"Different interests at first sight",
+			"Can become too comfortable to push each other",
+		},
+	},
+	RelationActivation: {
+		Name:     "Activation",
+		Dynamics: "The partners energize each other and share values, but their rhythms differ, so the relation is stimulating in small doses and tiring in large ones.",
+		Strengths: []string{
+			"Mutual encouragement and enthusiasm",
+			"Shared values",
+		},
+		Friction: []string{
+			"Different pace and timing",
+			"Overstimulation during long contact",
+		},
+	},
+	RelationMirror: {
+		Name:     "Mirror",
+		Dynamics: "Both work on the same problems from opposite ends, correcting and refining each other's ideas.",
+		Strengths: []string{
+			"Productive intellectual exchange",
+			"Each sees the other's ideas from a new angle",
+		},
+		Friction: []string{
+			"Frequent small corrections can feel like criticism",
+			"Disagreements about priorities",
+		},
+	},
+	RelationKindred: {
+		Name:     "Kindred",
+		Dynamics: "The partners see situations the same way but reach different conclusions about what to do, which leads to lively but friendly debates.",
+		Strengths: []string{
+			"Similar perception of the world",
+			"Easy start of the relationship",
+		},
+		Friction: []string{
+			"Misunderstandings about motives",
+			"Each expects the other to act like themselves",
+		},
+	},
+	RelationBusiness: {
+		Name:     "Business",
+		Dynamics: "The partners act in similar ways but for different reasons, which makes them good colleagues and more distant friends.",
+		Strengths: []string{
+			"Similar working style",
+			"Easy cooperation on practical tasks",
+		},
+		Friction: []string{
+			"Different underlying motivations",
+			"Superficial closeness",
+		},
+	},
+	RelationSemiDuality: {
+		Name:     "Semi-duality",
+		Dynamics: "One partner fills the other's biggest need, but the support only goes halfway, so the relation is attractive yet incomplete.",
+		Strengths: []string{
+			"Strong initial attraction",
+			"Each helps the other in a key area",
+		},
+		Friction: []string{
+			"Unmet expectations over time",
+			"Uneven support",
+		},
+	},
+	RelationMirage: {
+		Name:     "Mirage",
+		Dynamics: "A pleasant, relaxed relation where each partner seems to provide what the other needs, though it rarely leads to joint achievements.",
+		Strengths: []string{
+			"Comfortable, easy-going company",
+			"Mutual curiosity",
+		},
+		Friction: []string{
+			"Lack of productive cooperation",
+			"Misjudging each other's abilities",
+		},
+	},
+	RelationSuperEgo: {
+		Name:     "Super-ego",
+		Dynamics: "Each partner is strong where the other tries hard to meet expectations, which creates mutual respect at a distance and tension up close.",
+		Strengths: []string{
+			"Mutual respect",
+			"Learning from each other's competence",
+		},
+		Friction: []string{
+			"Feeling judged by the other",
+			"Different values and ideas of propriety",
+		},
+	},
+	RelationConflict: {
+		Name:     "Conflict",
+		Dynamics: "Each partner's strengths press directly on the other's weaknesses, so closeness requires a lot of conscious effort and patience.",
+		Strengths: []string{
+			"Exposure to a completely different perspective",
+			"Opportunities for growth",
+		},
+		Friction: []string{
+			"Unintentional hurt",
+			"Opposite values and communication styles",
+			"Fatigue during long contact",
+		},
+	},
+	RelationQuasiIdentity: {
+		Name:     "Quasi-identity",
+		Dynamics: "The partners seem alike and use the same concepts, but they apply them so differently that they often talk past each other.",
+		Strengths: []string{
+			"Common topics of interest",
+			"Interesting discussions",
+		},
+		Friction: []string{
+			"Persistent misunderstandings",
+			"Disagreement on how to approach problems",
+		},
+	},
+	RelationContrary: {
+		Name:     "Contrary",
+		Dynamics: "The partners share the same values and interests but express them with opposite energy, so they agree in theory and clash in practice.",
+		Strengths: []string{
+			"Shared interests",
+			"Understanding of each other's views",
+		},
+		Friction: []string{
+			"Opposite introversion and extraversion",
+			"Endless arguments with no winner",
+		},
+	},
+	RelationSupervisor: {
+		Name:     "Supervisor",
+		Dynamics: "This personality easily notices what its partner neglects and tends to correct it, often without realizing how much the remarks weigh.",
+		Strengths: []string{
+			"Can help the partner confront weak spots",
+		},
+		Friction: []string{
+			"Remarks feel like constant supervision to the partner",
+			"Unequal relation",
+		},
+	},
+	RelationSupervisee: {
+		Name:     "Supervisee",
+		Dynamics: "This personality feels observed and evaluated by its partner, whose casual remarks touch on its most vulnerable area.",
+		Strengths: []string{
+			"Motivation to develop weak spots",
+		},
+		Friction: []string{
+			"Feeling constantly criticized",
+			"Difficulty earning the partner's approval",
+		},
+	},
+	RelationBenefactor: {
+		Name:     "Benefactor",
+		Dynamics: "This personality offers ideas and help its partner finds valuable, but it rarely gets the partner's own insights back in return.",
+		Strengths: []string{
+			"Partner is appreciative and interested",
+			"Role of a mentor",
+		},
+		Friction: []string{
+			"One-directional flow of ideas",
+			"Feeling underappreciated over time",
+		},
+	},
+	RelationBeneficiary: {
+		Name:     "Beneficiary",
+		Dynamics: "This personality looks up to its partner and eagerly takes in its ideas, while its own contributions are often overlooked.",
+		Strengths: []string{
+			"Learning from an admired partner",
+		},
+		Friction: []string{
+			"Feeling one step behind",
+			"Own ideas go unnoticed",
+		},
+	},
+}
@@ -0,0 +1,40 @@
+package mbti
+
+import "testing"
+
+func TestRelationship(t *testing.T) {
+	for _, m := range Models {
+		for _, a := range All() {
+			a := a.WithModel(m)
+
+			for _, b := range All() {
+				b := b.WithModel(m)
+
+				var matches []RelationType
+
+				for r := RelationIdentity; r <= RelationBeneficiary; r++ {
+					if dom, aux := relationPartners[r](a.primary, a.auxiliary); dom == b.primary && aux == b.auxiliary {
+						matches = append(matches, r)
+					}
+				}
+
+				if len(matches) != 1 {
+					t.Fatalf("%s: %s and %s are in relations %v, want exactly one", m.Name(), a, b, matches)
+				}
+
+				r := Relationship(a, b)
+				if r != matches[0] {
+					t.Errorf("%s: Relationship(%s, %s) = %s, want %s", m.Name(), a, b, r, matches[0])
+				}
+
+				if inverse := Relationship(b, a); inverse != r.Inverse() {
+					t.Errorf("%s: Relationship(%s, %s) = %s, want %s", m.Name(), b, a, inverse, r.Inverse())
+				}
+
+				if p := a.Partner(r); p.String() != b.String() || p.Model() != m {
+					t.Errorf("%s: %s.Partner(%s) = %s (%s), want %s", m.Name(), a, r, p, p.Model().Name(), b)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package mbti
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SearchResult is a personality matching a Search query.
+type SearchResult struct {
+	Personality *Personality
+	Nickname    string
+	Group       string
+	Description string
+	// Score ranks the result; higher is better.
+	Score int
+	// Matches lists what the query matched: "indicator", "nickname", "group" or "description".
+	Matches []string
+}
+
+const (
+	scoreIndicator        = 100
+	scoreNickname         = 90
+	scoreNicknamePrefix   = 70
+	scoreNicknameFuzzy    = 50
+	scoreGroup            = 40
+	scoreDescriptionMatch = 10
+)
+
+func words(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// fuzzyMatch reports whether word is close enough to target to be considered a typo of it.
+func fuzzyMatch(word, target string) (int, bool) {
+	allowed := len(target) / 4
+	if allowed < 1 {
+		allowed = 1
+	}
+
+	d := levenshtein(word, target)
+
+	return d, d <= allowed
+}
+
+func scoreMetadata(query []string, indicator string, m Metadata) (int, []string) {
+	score := 0
+	var matches []string
+
+	match := func(kind string, points int) {
+		score += points
+		for _, m := range matches {
+			if m == kind {
+				return
+			}
+		}
+
+		matches = append(matches, kind)
+	}
+
+	nicknames := []string{strings.ToLower(m.Nickname)}
+	for _, alias := range m.Aliases {
+		nicknames = append(nicknames, strings.ToLower(alias))
+	}
+
+	group := strings.ToLower(m.Group)
+	description := words(m.Description)
+
+	for _, word := range query {
+		if word == strings.ToLower(indicator) {
+			match("indicator", scoreIndicator)
+		} else if points := scoreNicknames(word, nicknames); points > 0 {
+			match("nickname", points)
+		}
+
+		// Group names are plural, so also match their singular forms.
+		if word == group || word == strings.TrimSuffix(group, "s") {
+			match("group", scoreGroup)
+		}
+
+		if len(word) < 3 {
+			continue
+		}
+
+		for _, d := range description {
+			if strings.HasPrefix(d, word) {
+				match("description", scoreDescriptionMatch)
+
+				break
+			}
+		}
+	}
+
+	return score, matches
+}
+
+// scoreNicknames returns the score of the best match of the word among the nicknames.
+func scoreNicknames(word string, nicknames []string) int {
+	best := 0
+
+	for _, nickname := range nicknames {
+		points := 0
+
+		switch {
+		case nickname == "":
+		case word == nickname:
+			points = scoreNickname
+		case len(word) > 2 && strings.HasPrefix(nickname, word):
+			points = scoreNicknamePrefix
+		default:
+			if d, ok := fuzzyMatch(word, nickname); ok {
+				points = scoreNicknameFuzzy - d*10
+			}
+		}
+
+		if points > best {
+			best = points
+		}
+	}
+
+	return best
+}
+
+// Search finds the personalities whose nickname, group or description match the query,
+// best matches first. Nicknames tolerate small typos.
+func Search(query string) []SearchResult {
+	queryWords := words(query)
+
+	var results []SearchResult
+
+	for _, indicator := range indicators() {
+		m := metadataOf(indicator)
+
+		score, matches := scoreMetadata(queryWords, indicator, m)
+		if score == 0 {
+			continue
+		}
+
+		p, _ := FromIndicator(indicator)
+		results = append(results, SearchResult{
+			Personality: p,
+			Nickname:    m.Nickname,
+			Group:       m.Group,
+			Description: m.Description,
+			Score:       score,
+			Matches:     matches,
+		})
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+
+		return results[i].Personality.String() < results[j].Personality.String()
+	})
+
+	return results
+}
@@ -0,0 +1,77 @@
+package mbti
+
+import "strings"
+
+// FromSocionics creates a personality from its socionics code, either the
+// three-letter one, such as ILE, or the four-letter one, such as ENTp. The
+// personality is derived with ModelA.
+func FromSocionics(code string) (*Personality, error) {
+	p, err := socionicsNotation{}.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.WithModel(ModelA), nil
+}
+
+// Socionics returns the three-letter socionics code of the personality, such as ILE for ENTP.
+func (p *Personality) Socionics() string {
+	return socionicsCodes[p.primary.String()+p.auxiliary.String()]
+}
+
+// SocionicsFourLetter returns the four-letter socionics code of the personality,
+// such as INTj for INTP, whose last letter is lowercase and tells whether the
+// dominant function is rational (j) or irrational (p).
+func (p *Personality) SocionicsFourLetter() string {
+	return socionicsFourLetter(p)
+}
+
+// IndicatorToSocionics converts a Myers-Briggs indicator to the three-letter socionics code.
+func IndicatorToSocionics(indicator string) (string, error) {
+	p, err := FromIndicator(indicator)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Socionics(), nil
+}
+
+// SocionicsToIndicator converts a socionics code to the Myers-Briggs indicator.
+func SocionicsToIndicator(code string) (string, error) {
+	p, err := FromSocionics(code)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Indicator(), nil
+}
+
+// ModelAPositions names the positions of the eight functions of socionics Model A,
+// grouped in the ego, super-ego, super-id and id blocks.
+var ModelAPositions = [...]string{"Leading", "Creative", "Role", "Vulnerable", "Suggestive", "Mobilizing", "Ignoring", "Demonstrative"}
+
+// ModelAFunctions returns the functions of the personality in the positions
+// of socionics Model A, named by ModelAPositions, whatever its model is.
+func (p *Personality) ModelAFunctions() [8]Function {
+	dom, aux := p.primary, p.auxiliary
+
+	return [8]Function{
+		dom, aux,
+		dom.invertKind(), aux.invertKind(),
+		dom.invert(), aux.invert(),
+		dom.invertFocus(), aux.invertFocus(),
+	}
+}
+
+// FormatModelA writes the functions of the personality in Model A, block by block:
+// "Ne Ti | Se Fi | Si Fe | Ni Te".
+func (p *Personality) FormatModelA() string {
+	fns := p.ModelAFunctions()
+	blocks := make([]string, 0, len(fns)/2)
+
+	for i := 0; i < len(fns); i += 2 {
+		blocks = append(blocks, fns[i].String()+" "+fns[i+1].String())
+	}
+
+	return strings.Join(blocks, " | ")
+}
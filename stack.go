@@ -0,0 +1,80 @@
+package mbti
+
+import "fmt"
+
+// Role is the archetypal role of a function in John Beebe's eight-function model.
+type Role int
+
+const (
+	RoleHero Role = iota
+	RoleParent
+	RoleChild
+	RoleInferior
+	// RoleNemesis and the following roles are the shadow roles, taken by the ego
+	// functions with the opposite attitude.
+	RoleNemesis
+	RoleCritic
+	RoleTrickster
+	RoleDemon
+)
+
+var roleNames = [...]string{"Hero", "Parent", "Child", "Inferior", "Nemesis", "Critic", "Trickster", "Demon"}
+
+func (r Role) String() string {
+	if r < RoleHero || r > RoleDemon {
+		return fmt.Sprintf("Role(%d)", int(r))
+	}
+
+	return roleNames[r]
+}
+
+// Shadow reports whether the role belongs to the shadow, the four unconscious functions.
+func (r Role) Shadow() bool {
+	return r >= RoleNemesis
+}
+
+type StackEntry struct {
+	Function Function
+	Role     Role
+}
+
+// Stack returns all eight functions of the personality with their roles: the four
+// ego functions, then the same functions with the opposite attitude in the shadow.
+// The roles are those of Beebe's model, whose ego functions are the stack of
+// ModelGrant, so they don't depend on the model of the personality.
+func (p *Personality) Stack() []StackEntry {
+	ego := ModelGrant.Stack(p.primary, p.auxiliary)
+	ret := make([]StackEntry, 0, 2*len(ego))
+
+	for i, fn := range ego {
+		ret = append(ret, StackEntry{Function: fn, Role: Role(i)})
+	}
+
+	for i, fn := range ego {
+		ret = append(ret, StackEntry{Function: fn.invertFocus(), Role: RoleNemesis + Role(i)})
+	}
+
+	return ret
+}
+
+// FunctionInRole returns the function that plays the given role.
+// It returns false if the role isn't valid.
+func (p *Personality) FunctionInRole(r Role) (Function, bool) {
+	if r < RoleHero || r > RoleDemon {
+		return Function{}, false
+	}
+
+	return p.Stack()[r].Function, true
+}
+
+// RoleOf returns the role the function plays for the personality.
+// It returns false if the function isn't valid.
+func (p *Personality) RoleOf(fn Function) (Role, bool) {
+	for _, e := range p.Stack() {
+		if e.Function == fn {
+			return e.Role, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,90 @@
+package mbti
+
+// populationShares are the estimated percentages of each type in the
+// general US population, as published in the MBTI Manual.
+var populationShares = map[string]float64{
+	"ISTJ": 11.6, "ISFJ": 13.8, "INFJ": 1.5, "INTJ": 2.1,
+	"ISTP": 5.4, "ISFP": 8.8, "INFP": 4.4, "INTP": 3.3,
+	"ESTP": 4.3, "ESFP": 8.5, "ENFP": 8.1, "ENTP": 3.2,
+	"ESTJ": 8.7, "ESFJ": 12.3, "ENFJ": 2.5, "ENTJ": 1.8,
+}
+
+// Share is the number of personalities in a category and their fraction of
+// a dataset, next to the fraction expected in the general population.
+type Share struct {
+	Count    int
+	Fraction float64
+	Baseline float64
+}
+
+// Deviation returns how much the category is over- or underrepresented
+// compared to the population, as a difference of fractions.
+func (s Share) Deviation() float64 {
+	return s.Fraction - s.Baseline
+}
+
+// Distribution is the distribution of a dataset of personalities over the
+// types, the letters of the indicator and the temperaments.
+type Distribution struct {
+	Total int
+	// Types are keyed by indicator and hold all 16 types.
+	Types map[string]Share
+	// Letters are keyed by letter, one for each pole of the four dichotomies.
+	Letters      map[rune]Share
+	Temperaments map[Temperament]Share
+}
+
+// Letters lists the letters of the indicator, grouped by dichotomy.
+var Letters = [...]rune{
+	rune(FocusExternal), rune(FocusInternal),
+	rune(KindSensation), rune(KindIntuition),
+	rune(KindThinking), rune(KindFeeling),
+	tacticJudging, tacticProspecting,
+}
+
+// Distribute computes the distribution of the given personalities.
+func Distribute(personalities []*Personality) *Distribution {
+	d := &Distribution{
+		Total:        len(personalities),
+		Types:        make(map[string]Share, len(populationShares)),
+		Letters:      make(map[rune]Share, len(Letters)),
+		Temperaments: make(map[Temperament]Share, len(Temperaments)),
+	}
+
+	counts := make(map[string]int, len(populationShares))
+	for _, p := range personalities {
+		counts[p.Indicator()]++
+	}
+
+	var total float64
+	for _, share := range populationShares {
+		total += share
+	}
+
+	for _, indicator := range indicators() {
+		p, _ := parse(indicator)
+
+		count := counts[indicator]
+		baseline := populationShares[indicator] / total
+
+		d.Types[indicator] = d.add(d.Types[indicator], count, baseline)
+		d.Temperaments[p.Temperament()] = d.add(d.Temperaments[p.Temperament()], count, baseline)
+
+		for _, letter := range indicator {
+			d.Letters[letter] = d.add(d.Letters[letter], count, baseline)
+		}
+	}
+
+	return d
+}
+
+func (d *Distribution) add(s Share, count int, baseline float64) Share {
+	s.Count += count
+	s.Baseline += baseline
+
+	if d.Total > 0 {
+		s.Fraction = float64(s.Count) / float64(d.Total)
+	}
+
+	return s
+}
@@ -0,0 +1,52 @@
+package mbti
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxSuggestionDistance is the largest edit distance between an input and a suggestion.
+const maxSuggestionDistance = 2
+
+// Suggest returns the valid inputs closest to a mistyped one, best first:
+// type indicators for inputs resembling indicators and dominant function
+// pairs for inputs resembling function pairs. For inputs Parse accepts
+// the only suggestion is the canonical form of the personality, its indicator.
+func Suggest(input string) []string {
+	if p, err := parse(input); err == nil {
+		return []string{p.String()}
+	}
+
+	compact := strings.ToUpper(removeFunctionSeparators(input))
+
+	type suggestion struct {
+		text     string
+		distance int
+	}
+
+	var suggestions []suggestion
+
+	for _, indicator := range indicators() {
+		p, _ := FromIndicator(indicator)
+		pair := p.primary.String() + p.auxiliary.String()
+
+		if d := levenshtein(compact, indicator); d <= maxSuggestionDistance {
+			suggestions = append(suggestions, suggestion{text: indicator, distance: d})
+		}
+
+		if d := levenshtein(compact, strings.ToUpper(pair)); d <= maxSuggestionDistance {
+			suggestions = append(suggestions, suggestion{text: pair, distance: d})
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		return suggestions[i].distance < suggestions[j].distance
+	})
+
+	ret := make([]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		ret = append(ret, s.text)
+	}
+
+	return ret
+}
@@ -0,0 +1,91 @@
+package mbti
+
+// TeamMember is a named personality in a team.
+type TeamMember struct {
+	Name        string
+	Personality *Personality
+}
+
+// FunctionCoverage counts the team members that lead with a function or use it as their auxiliary.
+type FunctionCoverage struct {
+	Function  Function
+	Dominant  int
+	Auxiliary int
+}
+
+// PairDynamic is the relation between two team members, as seen from A.
+type PairDynamic struct {
+	A        TeamMember
+	B        TeamMember
+	Relation RelationType
+}
+
+// TeamAnalysis is the result of AnalyzeTeam.
+type TeamAnalysis struct {
+	Members      []TeamMember
+	Temperaments map[Temperament]int
+	// Coverage holds all eight functions, in the order Ni, Ne, Si, Se, Ti, Te, Fi, Fe.
+	Coverage []FunctionCoverage
+	// Gaps are the functions no member uses as dominant or auxiliary.
+	Gaps []Function
+	// Pairs are the pairs of members whose relation is notable, either
+	// because it is especially supportive or especially demanding.
+	Pairs []PairDynamic
+}
+
+// notableRelations are the relations reported in a team analysis.
+var notableRelations = map[RelationType]bool{
+	RelationDuality:     true,
+	RelationActivation:  true,
+	RelationMirror:      true,
+	RelationSuperEgo:    true,
+	RelationConflict:    true,
+	RelationSupervisor:  true,
+	RelationSupervisee:  true,
+	RelationSemiDuality: true,
+}
+
+// AnalyzeTeam reports the temperament balance, the function coverage and the notable pair dynamics of a team.
+func AnalyzeTeam(members []TeamMember) *TeamAnalysis {
+	t := &TeamAnalysis{
+		Members:      members,
+		Temperaments: make(map[Temperament]int, len(Temperaments)),
+	}
+
+	for _, temperament := range Temperaments {
+		t.Temperaments[temperament] = 0
+	}
+
+	for _, m := range members {
+		t.Temperaments[m.Personality.Temperament()]++
+	}
+
+	for _, fn := range AllFunctions {
+		c := FunctionCoverage{Function: fn}
+
+		for _, m := range members {
+			switch fn {
+			case m.Personality.primary:
+				c.Dominant++
+			case m.Personality.auxiliary:
+				c.Auxiliary++
+			}
+		}
+
+		if c.Dominant+c.Auxiliary == 0 {
+			t.Gaps = append(t.Gaps, fn)
+		}
+
+		t.Coverage = append(t.Coverage, c)
+	}
+
+	for i, a := range members {
+		for _, b := range members[i+1:] {
+			if r := relationship(a.Personality, b.Personality); notableRelations[r] {
+				t.Pairs = append(t.Pairs, PairDynamic{A: a, B: b, Relation: r})
+			}
+		}
+	}
+
+	return t
+}
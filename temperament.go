@@ -0,0 +1,38 @@
+package mbti
+
+// Temperament is one of Keirsey's four temperaments.
+type Temperament string
+
+const (
+	TemperamentNT Temperament = "NT"
+	TemperamentNF Temperament = "NF"
+	TemperamentSJ Temperament = "SJ"
+	TemperamentSP Temperament = "SP"
+)
+
+// Temperaments lists all temperaments.
+var Temperaments = [...]Temperament{TemperamentNT, TemperamentNF, TemperamentSJ, TemperamentSP}
+
+var temperamentNames = map[Temperament]string{
+	TemperamentNT: "Rationals",
+	TemperamentNF: "Idealists",
+	TemperamentSJ: "Guardians",
+	TemperamentSP: "Artisans",
+}
+
+// Name returns Keirsey's name of the temperament, such as Rationals for NT.
+func (t Temperament) Name() string {
+	return temperamentNames[t]
+}
+
+// Temperament returns the Keirsey temperament of the personality: intuitives
+// are grouped by their judging function, sensors by their lifestyle.
+func (p *Personality) Temperament() Temperament {
+	indicator := p.Indicator()
+
+	if Kind(indicator[1]) == KindIntuition {
+		return Temperament(indicator[1:3])
+	}
+
+	return Temperament([]byte{indicator[1], indicator[3]})
+}